@@ -6,9 +6,17 @@ import (
 )
 
 type jiraConfig struct {
-	BaseUrl  *string `cty:"base_url"`
-	Username *string `cty:"username"`
-	Token    *string `cty:"token"`
+	BaseUrl          *string `cty:"base_url"`
+	Username         *string `cty:"username"`
+	Token            *string `cty:"token"`
+	DefaultJQL       *string `cty:"default_jql"`
+	CacheTTLSeconds  *int    `cty:"cache_ttl_seconds"`
+	PageSize         *int    `cty:"page_size"`
+	StoryPointField  *string `cty:"story_point_field"`
+	FlaggedField     *string `cty:"flagged_field"`
+	DisableVotes     *bool   `cty:"disable_votes"`
+	DisableWatches   *bool   `cty:"disable_watches"`
+	IgnoreErrorCodes []int   `cty:"ignore_error_codes"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -21,6 +29,31 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"token": {
 		Type: schema.TypeString,
 	},
+	"default_jql": {
+		Type: schema.TypeString,
+	},
+	"cache_ttl_seconds": {
+		Type: schema.TypeInt,
+	},
+	"page_size": {
+		Type: schema.TypeInt,
+	},
+	"story_point_field": {
+		Type: schema.TypeString,
+	},
+	"flagged_field": {
+		Type: schema.TypeString,
+	},
+	"disable_votes": {
+		Type: schema.TypeBool,
+	},
+	"disable_watches": {
+		Type: schema.TypeBool,
+	},
+	"ignore_error_codes": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeInt},
+	},
 }
 
 func ConfigInstance() interface{} {
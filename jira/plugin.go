@@ -19,22 +19,54 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			Schema:      ConfigSchema,
 		},
 		TableMap: map[string]*plugin.Table{
-			"jira_advanced_setting": tableAdvancedSetting(ctx),
-			"jira_backlog_issue":    tableBacklogIssue(ctx),
-			"jira_board":            tableBoard(ctx),
-			"jira_component":        tableComponent(ctx),
-			"jira_dashboard":        tableDashboard(ctx),
-			"jira_epic":             tableEpic(ctx),
-			"jira_global_setting":   tableGlobalSetting(ctx),
-			"jira_group":            tableGroup(ctx),
-			"jira_issue":            tableIssue(ctx),
-			"jira_issue_type":       tableIssueType(ctx),
-			"jira_priority":         tablePriority(ctx),
-			"jira_project":          tableProject(ctx),
-			"jira_project_role":     tableProjectRole(ctx),
-			"jira_sprint":           tableSprint(ctx),
-			"jira_user":             tableUser(ctx),
-			"jira_workflow":         tableWorkflow(ctx),
+			"jira_advanced_setting":         tableAdvancedSetting(ctx),
+			"jira_assignable_user":          tableAssignableUser(ctx),
+			"jira_backlog_issue":            tableBacklogIssue(ctx),
+			"jira_board":                    tableBoard(ctx),
+			"jira_board_column":             tableBoardColumn(ctx),
+			"jira_board_sprint":             tableBoardSprint(ctx),
+			"jira_component":                tableComponent(ctx),
+			"jira_dashboard":                tableDashboard(ctx),
+			"jira_dashboard_item_property":  tableDashboardItemProperty(ctx),
+			"jira_epic":                     tableEpic(ctx),
+			"jira_epic_progress":            tableEpicProgress(ctx),
+			"jira_field_context":            tableFieldContext(ctx),
+			"jira_global_setting":           tableGlobalSetting(ctx),
+			"jira_group":                    tableGroup(ctx),
+			"jira_group_picker":             tableGroupPicker(ctx),
+			"jira_issue":                    tableIssue(ctx),
+			"jira_issue_comment_property":   tableIssueCommentProperty(ctx),
+			"jira_issue_development_info":   tableIssueDevelopmentInfo(ctx),
+			"jira_issue_label":              tableIssueLabel(ctx),
+			"jira_issue_property":           tableIssueProperty(ctx),
+			"jira_issue_type":               tableIssueType(ctx),
+			"jira_issue_type_hierarchy":     tableIssueTypeHierarchy(ctx),
+			"jira_license":                  tableLicense(ctx),
+			"jira_myself":                   tableMyself(ctx),
+			"jira_organization":             tableOrganization(ctx),
+			"jira_organization_user":        tableOrganizationUser(ctx),
+			"jira_permission_grant":         tablePermissionGrant(ctx),
+			"jira_priority":                 tablePriority(ctx),
+			"jira_project":                  tableProject(ctx),
+			"jira_project_email":            tableProjectEmail(ctx),
+			"jira_project_feature":          tableProjectFeature(ctx),
+			"jira_project_role":             tableProjectRole(ctx),
+			"jira_project_type":             tableProjectType(ctx),
+			"jira_request_type":             tableRequestType(ctx),
+			"jira_restricted_comment":       tableRestrictedComment(ctx),
+			"jira_role_default_actor":       tableRoleDefaultActor(ctx),
+			"jira_service_desk":             tableServiceDesk(ctx),
+			"jira_sla":                      tableSla(ctx),
+			"jira_sprint":                   tableSprint(ctx),
+			"jira_sprint_report":            tableSprintReport(ctx),
+			"jira_status_usage":             tableStatusUsage(ctx),
+			"jira_system_info":              tableSystemInfo(ctx),
+			"jira_user":                     tableUser(ctx),
+			"jira_user_picker":              tableUserPicker(ctx),
+			"jira_version_unresolved_issue": tableVersionUnresolvedIssue(ctx),
+			"jira_workflow":                 tableWorkflow(ctx),
+			"jira_workflow_transition":      tableWorkflowTransition(ctx),
+			"jira_worklog_summary":          tableWorklogSummary(ctx),
 		},
 	}
 
@@ -74,14 +74,14 @@ func listGlobalSettings(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		return nil, err
 	}
 
-	req, err := client.NewRequest("GET", "/rest/api/3/configuration", nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/3/configuration", nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_global_setting.listGlobalSettings", "get_request_error", err)
 		return nil, err
 	}
 
 	listGlobalSettings := new(GlobalSetting)
-	_, err = client.Do(req, listGlobalSettings)
+	_, err = doRequest(ctx, client, req, listGlobalSettings)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
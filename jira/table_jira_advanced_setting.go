@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
@@ -22,6 +23,9 @@ func tableAdvancedSetting(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAdvancedSettings,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "key", Require: plugin.Optional},
+			},
 		},
 		Columns: []*plugin.Column{
 			// top fields
@@ -59,6 +63,11 @@ func tableAdvancedSetting(_ context.Context) *plugin.Table {
 				Description: "The new value.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "default_value",
+				Description: "The default value of the application property.",
+				Type:        proto.ColumnType_STRING,
+			},
 
 			// JSON fields
 			{
@@ -87,16 +96,42 @@ func listAdvancedSettings(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 		return nil, err
 	}
 
-	req, err := client.NewRequest("GET", "/rest/api/3/application-properties/advanced-settings", nil)
+	// A single application property can be requested by its key, which also
+	// includes properties that are not shown on the Advanced Settings page
+	if key := d.KeyColumnQualString("key"); key != "" {
+		apiEndpoint := fmt.Sprintf("/rest/api/2/application-properties?key=%s", url.QueryEscape(key))
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_advanced_setting.listAdvancedSettings", "get_request_error", err)
+			return nil, err
+		}
+
+		result := new(AdvancedApplicationProperty)
+		_, err = doRequest(ctx, client, req, result)
+		if err != nil {
+			if isNotFoundError(err) || isBadRequestError(err) || isForbiddenError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_advanced_setting.listAdvancedSettings", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, *result)
+		return nil, nil
+	}
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/3/application-properties/advanced-settings", nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_advanced_setting.listAdvancedSettings", "get_request_error", err)
 		return nil, err
 	}
 
 	listAdvancedSettings := new([]AdvancedApplicationProperty)
-	_, err = client.Do(req, listAdvancedSettings)
+	_, err = doRequest(ctx, client, req, listAdvancedSettings)
 	if err != nil {
-		if isNotFoundError(err) {
+		// Only administrators can read the advanced settings
+		if isNotFoundError(err) || isForbiddenError(err) {
 			return nil, nil
 		}
 		plugin.Logger(ctx).Error("jira_advanced_setting.listAdvancedSettings", "api_error", err)
@@ -122,7 +157,7 @@ func getAdvancedSettingProperty(ctx context.Context, d *plugin.QueryData, _ *plu
 
 	apiEndpoint := fmt.Sprintf("/rest/api/3/application-properties?key=%s", ID)
 
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_advanced_setting.getAdvancedSettingProperty", "get_request_error", err)
 
@@ -131,7 +166,7 @@ func getAdvancedSettingProperty(ctx context.Context, d *plugin.QueryData, _ *plu
 
 	result := new(AdvancedApplicationProperty)
 
-	_, err = client.Do(req, result)
+	_, err = doRequest(ctx, client, req, result)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -150,6 +185,7 @@ type AdvancedApplicationProperty struct {
 	ID            string   `json:"id"`
 	Key           string   `json:"key"`
 	Value         string   `json:"value"`
+	DefaultValue  string   `json:"defaultValue"`
 	Name          string   `json:"name"`
 	Description   string   `json:"desc"`
 	Type          string   `json:"type"`
@@ -0,0 +1,153 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAssignableUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_assignable_user",
+		Description: "Users that can be assigned issues in a project, or assigned a specific issue. At most 1000 users are returned.",
+		List: &plugin.ListConfig{
+			Hydrate:    listAssignableUsers,
+			KeyColumns: plugin.AnyColumn([]string{"project_key", "issue_key"}),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "account_id",
+				Description: "The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountID"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the user. Depending on the user's privacy setting, this may return an alternative value.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_address",
+				Description: "The email address of the user. Depending on the user's privacy setting, this may be returned as null.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_type",
+				Description: "The user account type. Can take the following values: atlassian, app, customer and unknown.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active",
+				Description: "Indicates if user is active.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Active"),
+			},
+			{
+				Name:        "project_key",
+				Description: "The key of the project the users can be assigned issues in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_key"),
+			},
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue the users can be assigned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("issue_key"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAssignableUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_assignable_user.listAssignableUsers", "connection_error", err)
+		return nil, err
+	}
+
+	params := url.Values{}
+	if d.KeyColumnQualString("project_key") != "" {
+		params.Set("project", d.KeyColumnQualString("project_key"))
+	}
+	if d.KeyColumnQualString("issue_key") != "" {
+		params.Set("issueKey", d.KeyColumnQualString("issue_key"))
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	maxResults := getPageSize(d, 1000)
+
+	err = pageAssignableUsers(ctx, client, params, maxResults, func(user jira.User) bool {
+		d.StreamListItem(ctx, user)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.QueryStatus.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		// The project or issue doesn't exist
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_assignable_user.listAssignableUsers", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// assignableUserSearchLimit is the number of users the assignable user search
+// returns at most, however many are assignable
+const assignableUserSearchLimit = 1000
+
+// pageAssignableUsers:: pages through the assignable users matching the
+// params, calling stream with each one until it returns false. A page shorter
+// than maxResults is the last one.
+func pageAssignableUsers(ctx context.Context, client *jira.Client, params url.Values, maxResults int, stream func(jira.User) bool) error {
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/api/2/user/assignable/search?%s&startAt=%d&maxResults=%d", params.Encode(), last, maxResults)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		var users []jira.User
+		_, err = doRequest(ctx, client, req, &users)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			if !stream(user) {
+				return nil
+			}
+		}
+
+		last += len(users)
+		if len(users) < maxResults {
+			return nil
+		}
+		if last >= assignableUserSearchLimit {
+			plugin.Logger(ctx).Warn("jira_assignable_user.pageAssignableUsers", "search_limit_reached", assignableUserSearchLimit)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestPageAssignableUsers(t *testing.T) {
+	newPage := func(startAt int, size int) string {
+		users := []string{}
+		for i := 0; i < size; i++ {
+			users = append(users, fmt.Sprintf(`{"accountId": "%d"}`, startAt+i))
+		}
+		return "[" + strings.Join(users, ",") + "]"
+	}
+
+	tests := []struct {
+		name          string
+		total         int
+		maxResults    int
+		expectedUsers int
+		expectedCalls int
+	}{
+		{"short first page", 3, 5, 3, 1},
+		{"short last page", 12, 5, 12, 3},
+		{"empty last page", 10, 5, 10, 3},
+		{"search limit", 2000, 500, 1000, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Query().Get("project") != "ENG" {
+					t.Errorf("expected project ENG, got %q", r.URL.RawQuery)
+				}
+				startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+				size := test.total - startAt
+				if size > test.maxResults {
+					size = test.maxResults
+				}
+				if size < 0 {
+					size = 0
+				}
+				fmt.Fprint(w, newPage(startAt, size))
+			})
+
+			seen := map[string]bool{}
+			err := pageAssignableUsers(newTestContext(), client, url.Values{"project": {"ENG"}}, test.maxResults, func(user jira.User) bool {
+				seen[user.AccountID] = true
+				return true
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(seen) != test.expectedUsers {
+				t.Errorf("expected %d users, got %d", test.expectedUsers, len(seen))
+			}
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package jira
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
@@ -21,6 +20,9 @@ func tableBacklogIssue(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listBoards,
 			Hydrate:       listBacklogIssues,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "start_at", Require: plugin.Optional, CacheMatch: "exact"},
+			},
 		},
 		Columns: []*plugin.Column{
 			// top fields
@@ -181,6 +183,12 @@ func tableBacklogIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Fields.Labels"),
 			},
+			{
+				Name:        "start_at",
+				Description: "The index of the first issue to return for each board. Use it to resume a large export from a saved position.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("start_at"),
+			},
 
 			// Standard columns
 			{
@@ -210,17 +218,15 @@ func listBacklogIssues(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 
 	board := h.Item.(jira.Board)
 
+	// Resume paging from the given position, if any
 	last := 0
+	if d.KeyColumnQuals["start_at"] != nil {
+		last = int(d.KeyColumnQuals["start_at"].GetInt64Value())
+	}
 
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
-	}
+	maxResults := getPageSize(d, 1000)
 
 	var epicKey string
 	for {
@@ -231,9 +237,9 @@ func listBacklogIssues(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 			maxResults,
 		)
 
-		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 		if err != nil {
-			if isNotFoundError(err) || strings.Contains(err.Error(), "400") {
+			if isNotFoundError(err) || isBadRequestError(err) {
 				return nil, nil
 			}
 			plugin.Logger(ctx).Error("jira_backlog_issue.listBacklogIssues", "get_request_error", err)
@@ -241,7 +247,7 @@ func listBacklogIssues(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		}
 
 		listIssuesResult := new(ListIssuesResult)
-		_, err = client.Do(req, listIssuesResult)
+		_, err = doRequest(ctx, client, req, listIssuesResult)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_backlog_issue.listBacklogIssues", "api_error", err)
 			return nil, err
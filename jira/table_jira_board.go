@@ -2,6 +2,7 @@ package jira
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
@@ -23,6 +24,13 @@ func tableBoard(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listBoards,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				// Run after the configuration is memoized, so it isn't fetched twice
+				Func:    getBoardEstimationFieldName,
+				Depends: []plugin.HydrateFunc{getBoardConfiguration},
+			},
+		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "id",
@@ -59,6 +67,43 @@ func tableBoard(_ context.Context) *plugin.Table {
 				Hydrate:     getBoardConfiguration,
 				Transform:   transform.FromField("SubQuery.Query"),
 			},
+			{
+				Name:        "estimation_field_id",
+				Description: "The ID of the field used for estimation (Scrum only).",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBoardConfiguration,
+				Transform:   transform.From(extractBoardEstimationField),
+			},
+			{
+				Name:        "estimation_field_name",
+				Description: "The name of the field used for estimation, e.g. Story Points (Scrum only).",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBoardEstimationFieldName,
+				Transform:   transform.FromValue(),
+			},
+
+			// json fields
+			{
+				Name:        "column_config",
+				Description: "The columns of the board, with the statuses mapped to each column, and the constraint type.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBoardConfiguration,
+				Transform:   transform.FromField("ColumnConfig"),
+			},
+			{
+				Name:        "estimation",
+				Description: "The estimation type of the board, and the field used for estimation (Scrum only).",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBoardConfiguration,
+				Transform:   transform.FromField("Estimation").NullIfZero(),
+			},
+			{
+				Name:        "ranking",
+				Description: "The ID of the custom field used for ranking the issues of the board.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBoardConfiguration,
+				Transform:   transform.FromField("Ranking").NullIfZero(),
+			},
 
 			// Standard columns
 			{
@@ -83,13 +128,7 @@ func listBoards(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 	last := 0
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
-	}
+	maxResults := getPageSize(d, 1000)
 	for {
 		opt := jira.SearchOptions{
 			MaxResults: maxResults,
@@ -134,7 +173,7 @@ func getBoard(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 		return nil, err
 	}
 
-	board, _, err := client.Board.GetBoard(int(boardId))
+	board, _, err := client.Board.GetBoardWithContext(ctx, int(boardId))
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -149,17 +188,130 @@ func getBoard(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 func getBoardConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	board := h.Item.(jira.Board)
 
+	// The SDK calls this once per row however many of its columns are
+	// selected. Within a query the configuration of each board is memoized,
+	// whatever the TTL, and across queries it's reused within the TTL
+	cacheKey := fmt.Sprintf("jira_board.getBoardConfiguration.%d", board.ID)
+	memoKey := fmt.Sprintf("jira_board.getBoardConfiguration.%p.%d", d, board.ID)
+	cacheTTL := getCacheTTL(d)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(memoKey); ok {
+		return cachedData.(*BoardConfiguration), nil
+	}
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok && cacheTTL > 0 {
+		return cachedData.(*BoardConfiguration), nil
+	}
+
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_board.getBoardConfiguration", "connection_error", err)
 		return nil, err
 	}
 
-	boardConfiguration, _, err := client.Board.GetBoardConfiguration(board.ID)
+	// go-jira's BoardConfiguration doesn't include the estimation and ranking
+	apiEndpoint := fmt.Sprintf("/rest/agile/1.0/board/%d/configuration", board.ID)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_board.getBoardConfiguration", "get_request_error", err)
+		return nil, err
+	}
+
+	boardConfiguration := new(BoardConfiguration)
+	_, err = doRequest(ctx, client, req, boardConfiguration)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_board.getBoardConfiguration", "api_error", err)
 		return nil, err
 	}
 
-	return boardConfiguration, err
+	d.ConnectionManager.Cache.SetWithTTL(memoKey, boardConfiguration, queryMemoTTL)
+	if cacheTTL > 0 {
+		d.ConnectionManager.Cache.SetWithTTL(cacheKey, boardConfiguration, cacheTTL)
+	}
+
+	return boardConfiguration, nil
+}
+
+// getBoardEstimationFieldName:: returns the name of the estimation field of
+// the board, looked up by its ID in the fields of the instance. The display
+// name in the board configuration is used if the field isn't found.
+func getBoardEstimationFieldName(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// The configuration is memoized, so this doesn't fetch it again when
+	// other configuration columns are selected
+	result, err := getBoardConfiguration(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	configuration := result.(*BoardConfiguration)
+	if configuration.Estimation == nil || configuration.Estimation.Field == nil {
+		return nil, nil
+	}
+	estimationField := configuration.Estimation.Field
+
+	fields, err := getFields(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_board.getBoardEstimationFieldName", "api_error", err)
+		return nil, err
+	}
+	for _, field := range fields {
+		if field.ID == estimationField.FieldId {
+			return field.Name, nil
+		}
+	}
+
+	if estimationField.DisplayName == "" {
+		return nil, nil
+	}
+	return estimationField.DisplayName, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func extractBoardEstimationField(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	configuration, ok := d.HydrateItem.(*BoardConfiguration)
+	if !ok || configuration.Estimation == nil || configuration.Estimation.Field == nil {
+		return nil, nil
+	}
+	return configuration.Estimation.Field.FieldId, nil
+}
+
+//// Custom Structs
+
+type BoardConfiguration struct {
+	ID           int                             `json:"id"`
+	Name         string                          `json:"name"`
+	Self         string                          `json:"self"`
+	Location     jira.BoardConfigurationLocation `json:"location"`
+	Filter       jira.BoardConfigurationFilter   `json:"filter"`
+	SubQuery     jira.BoardConfigurationSubQuery `json:"subQuery"`
+	ColumnConfig BoardConfigurationColumnConfig  `json:"columnConfig"`
+	Estimation   *BoardConfigurationEstimation   `json:"estimation"`
+	Ranking      *BoardConfigurationRanking      `json:"ranking"`
+}
+
+// BoardConfigurationColumnConfig adds the WIP limits of the columns, which
+// go-jira's BoardConfigurationColumnConfig doesn't include
+type BoardConfigurationColumnConfig struct {
+	Columns        []BoardConfigurationColumn `json:"columns"`
+	ConstraintType string                     `json:"constraintType"`
+}
+
+type BoardConfigurationColumn struct {
+	Name     string                                `json:"name"`
+	Statuses []jira.BoardConfigurationColumnStatus `json:"statuses"`
+	Min      *int64                                `json:"min,omitempty"`
+	Max      *int64                                `json:"max,omitempty"`
+}
+
+type BoardConfigurationEstimation struct {
+	Type  string                             `json:"type"`
+	Field *BoardConfigurationEstimationField `json:"field,omitempty"`
+}
+
+type BoardConfigurationEstimationField struct {
+	FieldId     string `json:"fieldId"`
+	DisplayName string `json:"displayName"`
+}
+
+type BoardConfigurationRanking struct {
+	RankCustomFieldId int64 `json:"rankCustomFieldId"`
 }
@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableBoardColumn(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_board_column",
+		Description: "The columns of boards, with the statuses mapped to each column and the WIP limits.",
+		List: &plugin.ListConfig{
+			Hydrate: listBoardColumns,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "board_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "board_id",
+				Description: "The ID of the board.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "board_name",
+				Description: "The name of the board.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "column_name",
+				Description: "The name of the column.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Column.Name"),
+			},
+			{
+				Name:        "position",
+				Description: "The position of the column on the board, starting at 0.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min",
+				Description: "The minimum number of issues in the column. Null if no limit is set.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Column.Min"),
+			},
+			{
+				Name:        "max",
+				Description: "The maximum number of issues in the column (WIP limit). Null if no limit is set.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Column.Max"),
+			},
+			{
+				Name:        "constraint_type",
+				Description: "What the column limits are based on, e.g. issueCount or none.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "statuses",
+				Description: "The IDs of the statuses mapped to the column.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Column.Statuses").Transform(extractBoardColumnStatusIds),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Column.Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBoardColumns(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Only fetch the columns of the requested board, if one is given
+	if d.KeyColumnQuals["board_id"] != nil {
+		boardId := d.KeyColumnQuals["board_id"].GetInt64Value()
+		_, err := listColumnsForBoard(ctx, d, jira.Board{ID: int(boardId)})
+		return nil, err
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_board_column.listBoardColumns", "connection_error", err)
+		return nil, err
+	}
+
+	last := 0
+	maxResults := getPageSize(d, 1000)
+	for {
+		opt := jira.SearchOptions{
+			MaxResults: maxResults,
+			StartAt:    last,
+		}
+
+		boardList, resp, err := client.Board.GetAllBoardsWithContext(ctx, &jira.BoardListOptions{
+			SearchOptions: opt,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_board_column.listBoardColumns", "api_error", err)
+			return nil, err
+		}
+
+		for _, board := range boardList.Values {
+			done, err := listColumnsForBoard(ctx, d, board)
+			if err != nil || done {
+				return nil, err
+			}
+		}
+
+		last = resp.StartAt + len(boardList.Values)
+		if last >= resp.Total {
+			return nil, nil
+		}
+	}
+}
+
+// listColumnsForBoard streams the columns of a single board and reports
+// whether the query limit has been reached
+func listColumnsForBoard(ctx context.Context, d *plugin.QueryData, board jira.Board) (bool, error) {
+	columns, err := getBoardColumns(ctx, d, board)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_board_column.listColumnsForBoard", "api_error", err)
+		return false, err
+	}
+
+	for _, column := range columns {
+		d.StreamListItem(ctx, column)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getBoardColumns returns the columns of the board, in their order on the
+// board. A board that no longer exists has no columns.
+func getBoardColumns(ctx context.Context, d *plugin.QueryData, board jira.Board) ([]BoardColumnInfo, error) {
+	result, err := getBoardConfiguration(ctx, d, &plugin.HydrateData{Item: board})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	configuration := result.(*BoardConfiguration)
+	columnConfig := configuration.ColumnConfig
+
+	var columns []BoardColumnInfo
+	for i, column := range columnConfig.Columns {
+		columns = append(columns, BoardColumnInfo{
+			BoardId:        int64(board.ID),
+			BoardName:      configuration.Name,
+			Position:       i,
+			ConstraintType: columnConfig.ConstraintType,
+			Column:         column,
+		})
+	}
+
+	return columns, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func extractBoardColumnStatusIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	statusIds := []string{}
+	statuses, _ := d.Value.([]jira.BoardConfigurationColumnStatus)
+	for _, status := range statuses {
+		statusIds = append(statusIds, status.ID)
+	}
+	return statusIds, nil
+}
+
+//// Custom Structs
+
+type BoardColumnInfo struct {
+	BoardId        int64
+	BoardName      string
+	Position       int
+	ConstraintType string
+	Column         BoardConfigurationColumn
+}
@@ -0,0 +1,76 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func TestGetBoardColumnsKanban(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/agile/1.0/board/7/configuration" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		fmt.Fprint(w, `{
+			"id": 7,
+			"name": "Ops kanban",
+			"type": "kanban",
+			"columnConfig": {
+				"constraintType": "issueCount",
+				"columns": [
+					{"name": "Backlog", "statuses": [{"id": "10000"}]},
+					{"name": "Selected for Development", "statuses": [{"id": "10001"}], "max": 5},
+					{"name": "In Progress", "statuses": [{"id": "3"}, {"id": "10002"}], "min": 1, "max": 3},
+					{"name": "Done", "statuses": [{"id": "10003"}]}
+				]
+			}
+		}`)
+	})
+	d := newTestQueryData(client, jiraConfig{})
+
+	columns, err := getBoardColumns(newTestContext(), d, jira.Board{ID: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	int64Value := func(i int64) *int64 { return &i }
+	expected := []struct {
+		name     string
+		statuses []string
+		min      *int64
+		max      *int64
+	}{
+		{"Backlog", []string{"10000"}, nil, nil},
+		{"Selected for Development", []string{"10001"}, nil, int64Value(5)},
+		{"In Progress", []string{"3", "10002"}, int64Value(1), int64Value(3)},
+		{"Done", []string{"10003"}, nil, nil},
+	}
+	if len(columns) != len(expected) {
+		t.Fatalf("expected %d columns, got %d", len(expected), len(columns))
+	}
+
+	for i, column := range columns {
+		if column.BoardId != 7 || column.BoardName != "Ops kanban" || column.ConstraintType != "issueCount" || column.Position != i {
+			t.Errorf("unexpected board details for column %d: %+v", i, column)
+		}
+		if column.Column.Name != expected[i].name {
+			t.Errorf("expected column %q at %d, got %q", expected[i].name, i, column.Column.Name)
+		}
+		if !reflect.DeepEqual(column.Column.Min, expected[i].min) || !reflect.DeepEqual(column.Column.Max, expected[i].max) {
+			t.Errorf("unexpected limits for %s: %v and %v", column.Column.Name, column.Column.Min, column.Column.Max)
+		}
+
+		statuses, err := extractBoardColumnStatusIds(context.Background(), &transform.TransformData{Value: column.Column.Statuses})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(statuses, expected[i].statuses) {
+			t.Errorf("expected statuses %v for %s, got %v", expected[i].statuses, column.Column.Name, statuses)
+		}
+	}
+}
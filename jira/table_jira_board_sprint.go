@@ -0,0 +1,143 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableBoardSprint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_board_sprint",
+		Description: "Sprints of a single board, optionally filtered by state.",
+		List: &plugin.ListConfig{
+			Hydrate: listBoardSprints,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "board_id", Require: plugin.Required},
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the sprint.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the sprint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "board_id",
+				Description: "The ID of the board the sprints are listed for.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "origin_board_id",
+				Description: "The ID of the board the sprint was created on.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("OriginBoardID"),
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the sprint details.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Status of the sprint. Valid values are active, future and closed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_date",
+				Description: "The start timestamp of the sprint.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromCamel().NullIfZero(),
+			},
+			{
+				Name:        "end_date",
+				Description: "The projected time of completion of the sprint.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromCamel().NullIfZero(),
+			},
+			{
+				Name:        "complete_date",
+				Description: "Date the sprint was marked as complete.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromCamel().NullIfZero(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBoardSprints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	boardId := d.KeyColumnQuals["board_id"].GetInt64Value()
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_board_sprint.listBoardSprints", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	maxResults := getPageSize(d, 1000)
+
+	last := 0
+	for {
+		opt := &jira.GetAllSprintsOptions{
+			State: d.KeyColumnQualString("state"),
+			SearchOptions: jira.SearchOptions{
+				MaxResults: maxResults,
+				StartAt:    last,
+			},
+		}
+
+		sprintList, _, err := client.Board.GetAllSprintsWithOptionsWithContext(ctx, int(boardId), opt)
+		if err != nil {
+			if isNotFoundError(err) || isBadRequestError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_board_sprint.listBoardSprints", "api_error", err)
+			return nil, err
+		}
+
+		for _, sprint := range sprintList.Values {
+			d.StreamListItem(ctx, BoardSprintInfo{boardId, sprint})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = sprintList.StartAt + len(sprintList.Values)
+		if sprintList.IsLast {
+			return nil, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type BoardSprintInfo struct {
+	BoardId int64
+	jira.Sprint
+}
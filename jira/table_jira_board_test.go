@@ -0,0 +1,199 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+func TestBoardConfigurationColumnsShareHydrate(t *testing.T) {
+	// The SDK calls each hydrate function once per row, so the columns must
+	// share getBoardConfiguration for the endpoint to be called once per board
+	columns := map[string]bool{"filter_id": true, "sub_query": true}
+
+	for _, column := range tableBoard(context.Background()).Columns {
+		if !columns[column.Name] {
+			continue
+		}
+		delete(columns, column.Name)
+		if helpers.GetFunctionName(column.Hydrate) != helpers.GetFunctionName(getBoardConfiguration) {
+			t.Errorf("expected column %s to be hydrated by getBoardConfiguration", column.Name)
+		}
+	}
+	for column := range columns {
+		t.Errorf("column %s not found", column)
+	}
+}
+
+func TestGetBoardConfigurationCache(t *testing.T) {
+	cacheTTLSeconds := 300
+
+	tests := []struct {
+		name          string
+		config        jiraConfig
+		sameQuery     bool
+		expectedCalls int32
+	}{
+		{"cache disabled", jiraConfig{}, false, 2},
+		{"cache disabled in one query", jiraConfig{}, true, 1},
+		{"cache enabled", jiraConfig{CacheTTLSeconds: &cacheTTLSeconds}, false, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				fmt.Fprint(w, `{"id": 1, "name": "Board", "filter": {"id": "10000"}, "subQuery": {"query": "resolution = EMPTY"}}`)
+			})
+			d := newTestQueryData(client, test.config)
+			h := &plugin.HydrateData{Item: jira.Board{ID: 1}}
+
+			for i := 0; i < 2; i++ {
+				// Each query has its own query data, sharing the connection
+				queryData := d
+				if !test.sameQuery {
+					queryData = &plugin.QueryData{Connection: d.Connection, ConnectionManager: d.ConnectionManager, QueryContext: d.QueryContext}
+				}
+
+				configuration, err := getBoardConfiguration(newTestContext(), queryData, h)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if configuration.(*BoardConfiguration).SubQuery.Query != "resolution = EMPTY" {
+					t.Errorf("unexpected configuration %+v", configuration)
+				}
+			}
+
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestGetBoardWithConfigurationColumns(t *testing.T) {
+	var boardCalls, configurationCalls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/agile/1.0/board/1":
+			atomic.AddInt32(&boardCalls, 1)
+			fmt.Fprint(w, `{"id": 1, "name": "Board", "type": "kanban"}`)
+		case "/rest/agile/1.0/board/1/configuration":
+			atomic.AddInt32(&configurationCalls, 1)
+			fmt.Fprint(w, `{"id": 1, "name": "Board", "filter": {"id": "10000"}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := newTestQueryData(client, jiraConfig{})
+	d.QueryContext.Columns = []string{"name", "filter_id"}
+	d.KeyColumnQuals = map[string]*proto.QualValue{
+		"id": {Value: &proto.QualValue_Int64Value{Int64Value: 1}},
+	}
+
+	// The Get hydrate returns the board, which is the item of the hydrate
+	// functions of the other selected columns. Like the SDK, call each of
+	// them once per row.
+	board, err := getBoard(newTestContext(), d, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	table := tableBoard(context.Background())
+	called := map[string]bool{}
+	var filterId interface{}
+	for _, column := range table.Columns {
+		if column.Hydrate == nil || !isColumnRequested(d, column.Name) {
+			continue
+		}
+		name := helpers.GetFunctionName(column.Hydrate)
+		if called[name] {
+			continue
+		}
+		called[name] = true
+
+		result, err := column.Hydrate(newTestContext(), d, &plugin.HydrateData{Item: board})
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", column.Name, err)
+		}
+		filterId = result.(*BoardConfiguration).Filter.ID
+	}
+
+	if board.(jira.Board).Name != "Board" || fmt.Sprint(filterId) != "10000" {
+		t.Errorf("expected board Board with filter 10000, got %v and %v", board, filterId)
+	}
+	if boardCalls != 1 || configurationCalls != 1 {
+		t.Errorf("expected 1 board and 1 configuration call, got %d and %d", boardCalls, configurationCalls)
+	}
+}
+
+func TestGetBoardEstimationFieldName(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration string
+		expected      interface{}
+	}{
+		{
+			"field found",
+			`{"id": 1, "estimation": {"type": "field", "field": {"fieldId": "customfield_10016", "displayName": "Story point estimate"}}}`,
+			"Story Points",
+		},
+		{
+			"field not found",
+			`{"id": 1, "estimation": {"type": "field", "field": {"fieldId": "customfield_99999", "displayName": "Size"}}}`,
+			"Size",
+		},
+		{
+			"no estimation",
+			`{"id": 1}`,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var fieldCalls int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/rest/agile/1.0/board/1/configuration":
+					fmt.Fprint(w, test.configuration)
+				case "/rest/api/2/field":
+					atomic.AddInt32(&fieldCalls, 1)
+					fmt.Fprint(w, `[
+						{"id": "summary", "name": "Summary", "custom": false},
+						{"id": "customfield_10016", "name": "Story Points", "custom": true}
+					]`)
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+			d := newTestQueryData(client, jiraConfig{})
+			h := &plugin.HydrateData{Item: jira.Board{ID: 1}}
+
+			// The field list is cached for the connection
+			for i := 0; i < 2; i++ {
+				actual, err := getBoardEstimationFieldName(newTestContext(), d, h)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if actual != test.expected {
+					t.Errorf("expected %v, got %v", test.expected, actual)
+				}
+			}
+			if fieldCalls > 1 {
+				t.Errorf("expected at most 1 field call, got %d", fieldCalls)
+			}
+		})
+	}
+}
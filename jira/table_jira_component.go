@@ -141,25 +141,19 @@ func listComponents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 	last := 0
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
-	}
+	maxResults := getPageSize(d, 1000)
 
 	for {
 		apiEndpoint := fmt.Sprintf("/rest/api/3/project/%s/component?startAt=%d&maxResults=%d", project.ID, last, maxResults)
 
-		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_component.listComponents", "get_request_error", err)
 			return nil, err
 		}
 
 		listResult := new(ListComponentResult)
-		_, err = client.Do(req, listResult)
+		_, err = doRequest(ctx, client, req, listResult)
 		if err != nil {
 			if isNotFoundError(err) {
 				return nil, nil
@@ -189,6 +183,11 @@ func listComponents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 func getComponent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	componentId := d.KeyColumnQuals["id"].GetStringValue()
 
+	// Return nil, if no input provided
+	if componentId == "" {
+		return nil, nil
+	}
+
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_component.getComponent", "connection_error", err)
@@ -197,7 +196,7 @@ func getComponent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 
 	apiEndpoint := fmt.Sprintf("/rest/api/3/component/%s", componentId)
 
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_component.getComponent", "get_request_error", err)
 		return nil, err
@@ -205,8 +204,11 @@ func getComponent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 
 	result := new(Component)
 
-	_, err = client.Do(req, result)
+	_, err = doRequest(ctx, client, req, result)
 	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
 		plugin.Logger(ctx).Error("jira_component.getComponent", "api_error", err)
 		return nil, err
 	}
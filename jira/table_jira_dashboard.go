@@ -3,6 +3,8 @@ package jira
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
@@ -23,6 +25,10 @@ func tableDashboard(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listDashboards,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "owner_account_id", Require: plugin.Optional},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -106,12 +112,22 @@ func listDashboards(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	last := 0
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
+	maxResults := getPageSize(d, 1000)
+
+	// The search endpoint supports filtering by name and owner, but the plain
+	// list endpoint is kept for unfiltered queries
+	query := getDashboardSearchParams(d)
+	if len(query) > 0 {
+		err = searchDashboards(ctx, client, query, maxResults, func(dashboard Dashboard) bool {
+			d.StreamListItem(ctx, dashboard)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			return d.QueryStatus.RowsRemaining(ctx) != 0
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_dashboard.listDashboards", "api_error", err)
+			return nil, err
 		}
+		return nil, nil
 	}
 
 	for {
@@ -121,14 +137,14 @@ func listDashboards(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 			maxResults,
 		)
 
-		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_dashboard.listDashboards", "get_request_error", err)
 			return nil, err
 		}
 
 		listResult := new(ListResult)
-		_, err = client.Do(req, listResult)
+		_, err = doRequest(ctx, client, req, listResult)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_dashboard.listDashboards", "api_error", err)
 			return nil, err
@@ -165,13 +181,13 @@ func getDashboard(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 		return nil, err
 	}
 	apiEndpoint := fmt.Sprintf("/rest/api/3/dashboard/%s", dashboardId)
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_dashboard.getDashboard", "get_request_error", err)
 		return nil, err
 	}
 
-	_, err = client.Do(req, dashboard)
+	_, err = doRequest(ctx, client, req, dashboard)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -183,6 +199,56 @@ func getDashboard(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	return dashboard, nil
 }
 
+//// UTILITY FUNCTIONS
+
+// getDashboardSearchParams:: returns the search params for the name and
+// owner_account_id quals, or no params if neither is set
+func getDashboardSearchParams(d *plugin.QueryData) url.Values {
+	query := url.Values{}
+	if d.KeyColumnQualString("name") != "" {
+		query.Set("dashboardName", d.KeyColumnQualString("name"))
+	}
+	if d.KeyColumnQualString("owner_account_id") != "" {
+		query.Set("accountId", d.KeyColumnQualString("owner_account_id"))
+	}
+	return query
+}
+
+// searchDashboards:: pages through the dashboards matching the search params,
+// calling stream with each one until it returns false
+func searchDashboards(ctx context.Context, client *jira.Client, query url.Values, maxResults int, stream func(Dashboard) bool) error {
+	query.Set("expand", "owner,favourite,sharePermissions,editPermissions,viewUrl")
+	query.Set("maxResults", strconv.Itoa(maxResults))
+
+	last := 0
+	for {
+		query.Set("startAt", strconv.Itoa(last))
+		apiEndpoint := fmt.Sprintf("/rest/api/3/dashboard/search?%s", query.Encode())
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		listResult := new(SearchDashboardResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			return err
+		}
+
+		for _, dashboard := range listResult.Values {
+			if !stream(dashboard) {
+				return nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil
+		}
+	}
+}
+
 //// Custom Structs
 
 type ListResult struct {
@@ -193,6 +259,14 @@ type ListResult struct {
 	Values     []Dashboard `json:"dashboards"`
 }
 
+type SearchDashboardResult struct {
+	MaxResults int         `json:"maxResults"`
+	StartAt    int         `json:"startAt"`
+	Total      int         `json:"total"`
+	IsLast     bool        `json:"isLast"`
+	Values     []Dashboard `json:"values"`
+}
+
 type Dashboard struct {
 	Id               string            `json:"id"`
 	IsFavourite      bool              `json:"isFavourite"`
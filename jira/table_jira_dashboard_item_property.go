@@ -0,0 +1,168 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableDashboardItemProperty(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_dashboard_item_property",
+		Description: "Custom properties stored against a dashboard item (gadget).",
+		List: &plugin.ListConfig{
+			Hydrate: listDashboardItemProperties,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "dashboard_id", Require: plugin.Required},
+				{Name: "item_id", Require: plugin.Required},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				// Limit concurrency to avoid a 429 too many requests error
+				Func:           getDashboardItemProperty,
+				MaxConcurrency: 10,
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "dashboard_id",
+				Description: "The ID of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "item_id",
+				Description: "The ID of the dashboard item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the property.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "value",
+				Description: "The value of the property.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDashboardItemProperty,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDashboardItemProperties(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	dashboardId := d.KeyColumnQualString("dashboard_id")
+	itemId := d.KeyColumnQualString("item_id")
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_item_property.listDashboardItemProperties", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/3/dashboard/%s/items/%s/properties", url.PathEscape(dashboardId), url.PathEscape(itemId))
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_item_property.listDashboardItemProperties", "get_request_error", err)
+		return nil, err
+	}
+
+	propertyKeys := new(EntityPropertyKeys)
+	_, err = doRequest(ctx, client, req, propertyKeys)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_dashboard_item_property.listDashboardItemProperties", "api_error", err)
+		return nil, err
+	}
+
+	for _, propertyKey := range propertyKeys.Keys {
+		d.StreamListItem(ctx, DashboardItemPropertyInfo{dashboardId, itemId, propertyKey.Key})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDashboardItemProperty(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	property := h.Item.(DashboardItemPropertyInfo)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_item_property.getDashboardItemProperty", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf(
+		"/rest/api/3/dashboard/%s/items/%s/properties/%s",
+		url.PathEscape(property.DashboardId),
+		url.PathEscape(property.ItemId),
+		url.PathEscape(property.Key),
+	)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_item_property.getDashboardItemProperty", "get_request_error", err)
+		return nil, err
+	}
+
+	result := new(EntityProperty)
+	_, err = doRequest(ctx, client, req, result)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_dashboard_item_property.getDashboardItemProperty", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//// Custom Structs
+
+type EntityPropertyKeys struct {
+	Keys []EntityPropertyKey `json:"keys"`
+}
+
+type EntityPropertyKey struct {
+	Self string `json:"self"`
+	Key  string `json:"key"`
+}
+
+type EntityProperty struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+type DashboardItemPropertyInfo struct {
+	DashboardId string
+	ItemId      string
+	Key         string
+}
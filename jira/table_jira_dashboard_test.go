@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+)
+
+func TestDashboardSearchParams(t *testing.T) {
+	stringValue := func(s string) *proto.QualValue {
+		return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: s}}
+	}
+
+	tests := []struct {
+		name     string
+		quals    map[string]*proto.QualValue
+		expected map[string]string
+	}{
+		{"no quals", map[string]*proto.QualValue{}, map[string]string{}},
+		{"name", map[string]*proto.QualValue{"name": stringValue("Team")}, map[string]string{"dashboardName": "Team"}},
+		{"owner", map[string]*proto.QualValue{"owner_account_id": stringValue("5b10a2844c20165700ede21g")}, map[string]string{"accountId": "5b10a2844c20165700ede21g"}},
+		{
+			"name and owner",
+			map[string]*proto.QualValue{"name": stringValue("Team"), "owner_account_id": stringValue("5b10a2844c20165700ede21g")},
+			map[string]string{"dashboardName": "Team", "accountId": "5b10a2844c20165700ede21g"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := newTestQueryData(nil, jiraConfig{})
+			d.KeyColumnQuals = test.quals
+
+			var requested map[string]string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rest/api/3/dashboard/search" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				requested = map[string]string{}
+				for _, param := range []string{"dashboardName", "accountId"} {
+					if r.URL.Query().Has(param) {
+						requested[param] = r.URL.Query().Get(param)
+					}
+				}
+				fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "isLast": true, "values": [{"id": "10000", "name": "Team board"}]}`)
+			})
+
+			query := getDashboardSearchParams(d)
+			// Without quals, the plain list endpoint is used instead
+			if len(test.expected) == 0 {
+				if len(query) != 0 {
+					t.Errorf("expected no search params, got %v", query)
+				}
+				return
+			}
+
+			var dashboards []string
+			err := searchDashboards(newTestContext(), client, query, 50, func(dashboard Dashboard) bool {
+				dashboards = append(dashboards, dashboard.Name)
+				return true
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(requested, test.expected) {
+				t.Errorf("expected params %v, got %v", test.expected, requested)
+			}
+			if len(dashboards) != 1 || dashboards[0] != "Team board" {
+				t.Errorf("expected the dashboard of the fixture, got %v", dashboards)
+			}
+		})
+	}
+}
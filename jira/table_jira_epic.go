@@ -3,7 +3,6 @@ package jira
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
@@ -84,13 +83,7 @@ func listEpics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 	last := 0
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 100
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 100 {
-			maxResults = int(*queryLimit)
-		}
-	}
+	maxResults := getPageSize(d, 100)
 	for {
 		apiEndpoint := fmt.Sprintf(
 			"/rest/agile/1.0/epic/search?startAt=%d&maxResults=%d",
@@ -98,14 +91,14 @@ func listEpics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 			maxResults,
 		)
 
-		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_epic.listEpics", "get_request_error", err)
 			return nil, err
 		}
 
 		listResult := new(ListEpicResult)
-		_, err = client.Do(req, listResult)
+		_, err = doRequest(ctx, client, req, listResult)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_epic.listEpics", "api_error", err)
 			return nil, err
@@ -148,16 +141,16 @@ func getEpic(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (i
 		return nil, err
 	}
 
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_epic.getEpic", "get_request_error", err)
 		return nil, err
 	}
 
 	epic := new(Epic)
-	_, err = client.Do(req, epic)
+	_, err = doRequest(ctx, client, req, epic)
 	if err != nil {
-		if isNotFoundError(err) || strings.Contains(err.Error(), "400") {
+		if isNotFoundError(err) || isBadRequestError(err) {
 			return nil, nil
 		}
 		plugin.Logger(ctx).Error("jira_epic.getEpic", "api_error", err)
@@ -0,0 +1,261 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableEpicProgress(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_epic_progress",
+		Description: "Story point and issue count rollup of the child issues of epics.",
+		List: &plugin.ListConfig{
+			Hydrate:    listEpicProgress,
+			KeyColumns: plugin.AnyColumn([]string{"board_id", "epic_key"}),
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				// Limit concurrency to avoid a 429 too many requests error
+				Func:           getEpicProgress,
+				MaxConcurrency: 10,
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "board_id",
+				Description: "The ID of the board the epics are listed for.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("BoardId").NullIfZero(),
+			},
+			{
+				Name:        "epic_key",
+				Description: "The key of the epic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "epic_name",
+				Description: "The name of the epic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EpicName").NullIfZero(),
+			},
+			{
+				Name:        "total_points",
+				Description: "The sum of the story points of the child issues. Null if the story point field can't be found or none of the child issues is estimated.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEpicProgress,
+			},
+			{
+				Name:        "done_points",
+				Description: "The sum of the story points of the child issues in a done status. Null if the story point field can't be found or none of the child issues is estimated.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEpicProgress,
+			},
+			{
+				Name:        "issue_count",
+				Description: "The number of child issues.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getEpicProgress,
+			},
+			{
+				Name:        "done_count",
+				Description: "The number of child issues in a done status.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getEpicProgress,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EpicKey"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listEpicProgress(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	epicKey := d.KeyColumnQualString("epic_key")
+	boardId := d.KeyColumnQuals["board_id"].GetInt64Value()
+
+	if boardId == 0 {
+		d.StreamListItem(ctx, EpicProgressInfo{EpicKey: epicKey})
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_epic_progress.listEpicProgress", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	maxResults := getPageSize(d, 100)
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"/rest/agile/1.0/board/%d/epic?startAt=%d&maxResults=%d",
+			boardId,
+			last,
+			maxResults,
+		)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_epic_progress.listEpicProgress", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListEpicResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			// Kanban boards without epics enabled return a 400
+			if isNotFoundError(err) || isBadRequestError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_epic_progress.listEpicProgress", "api_error", err)
+			return nil, err
+		}
+
+		for _, epic := range listResult.Values {
+			// Only return the requested epic, if one is given
+			if epicKey != "" && epicKey != epic.Key {
+				continue
+			}
+			d.StreamListItem(ctx, EpicProgressInfo{boardId, epic.Key, epic.Name})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEpicProgress(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	epic := h.Item.(EpicProgressInfo)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_epic_progress.getEpicProgress", "connection_error", err)
+		return nil, err
+	}
+
+	// Company-managed projects on Server and Data Center link issues to
+	// epics with the Epic Link field, Cloud uses the parent for all projects
+	isCloud, err := isCloudDeployment(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_epic_progress.getEpicProgress", "server_info_error", err)
+		return nil, err
+	}
+	childJQL := fmt.Sprintf("\"Epic Link\" = \"%s\"", epic.EpicKey)
+	if isCloud {
+		childJQL = fmt.Sprintf("parent = \"%s\"", epic.EpicKey)
+	}
+
+	jiraConfig := GetConfig(d.Connection)
+	var defaultJQL string
+	if jiraConfig.DefaultJQL != nil {
+		defaultJQL = *jiraConfig.DefaultJQL
+	}
+	jql, err := combineJQL(defaultJQL, childJQL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only fetch the fields needed for the rollup
+	storyPointField, err := getStoryPointField(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_epic_progress.getEpicProgress", "story_point_field_error", err)
+		return nil, err
+	}
+	fields := []string{"status"}
+	if storyPointField != "" {
+		fields = append(fields, storyPointField)
+	}
+
+	options := jira.SearchOptions{
+		StartAt:    0,
+		MaxResults: 100,
+		Fields:     fields,
+	}
+
+	// The story points stay null unless the field is found and at least one
+	// child issue is estimated
+	progress := &EpicProgress{}
+
+	for {
+		issues, resp, err := client.Issue.SearchWithContext(ctx, jql, &options)
+		if err != nil {
+			if isNotFoundError(err) || isBadRequestError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_epic_progress.getEpicProgress", "api_error", err)
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			if issue.Fields == nil {
+				continue
+			}
+
+			done := issue.Fields.Status != nil && issue.Fields.Status.StatusCategory.Key == "done"
+			progress.IssueCount++
+			if done {
+				progress.DoneCount++
+			}
+
+			points, ok := issue.Fields.Unknowns[storyPointField].(float64)
+			if storyPointField == "" || !ok {
+				continue
+			}
+			if progress.TotalPoints == nil {
+				progress.TotalPoints = new(float64)
+				progress.DonePoints = new(float64)
+			}
+			*progress.TotalPoints += points
+			if done {
+				*progress.DonePoints += points
+			}
+		}
+
+		last := resp.StartAt + len(issues)
+		if last >= resp.Total || len(issues) == 0 {
+			return progress, nil
+		}
+		options.StartAt = last
+	}
+}
+
+//// Custom Structs
+
+type EpicProgressInfo struct {
+	BoardId  int64
+	EpicKey  string
+	EpicName string
+}
+
+type EpicProgress struct {
+	TotalPoints *float64
+	DonePoints  *float64
+	IssueCount  int64
+	DoneCount   int64
+}
@@ -0,0 +1,98 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+func TestGetEpicProgress(t *testing.T) {
+	issuesFixture := `{"startAt": 0, "maxResults": 100, "total": 3, "issues": [
+		{"id": "1", "key": "ENG-2", "fields": {"status": {"statusCategory": {"key": "done"}}, "customfield_10016": 3}},
+		{"id": "2", "key": "ENG-3", "fields": {"status": {"statusCategory": {"key": "indeterminate"}}, "customfield_10016": 5}},
+		{"id": "3", "key": "ENG-4", "fields": {"status": {"statusCategory": {"key": "done"}}}}
+	]}`
+	unestimatedFixture := `{"startAt": 0, "maxResults": 100, "total": 1, "issues": [
+		{"id": "3", "key": "ENG-4", "fields": {"status": {"statusCategory": {"key": "done"}}}}
+	]}`
+	float := func(f float64) *float64 { return &f }
+
+	tests := []struct {
+		name     string
+		fields   string
+		issues   string
+		expected EpicProgress
+	}{
+		{
+			"discovered field",
+			`[{"id": "customfield_10016", "name": "Story point estimate", "custom": true}]`,
+			issuesFixture,
+			EpicProgress{TotalPoints: float(8), DonePoints: float(3), IssueCount: 3, DoneCount: 2},
+		},
+		{
+			"no story point field",
+			`[{"id": "customfield_10020", "name": "Sprint", "custom": true}]`,
+			issuesFixture,
+			EpicProgress{IssueCount: 3, DoneCount: 2},
+		},
+		{
+			"no estimated issues",
+			`[{"id": "customfield_10016", "name": "Story Points", "custom": true}]`,
+			unestimatedFixture,
+			EpicProgress{IssueCount: 1, DoneCount: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/rest/api/2/serverInfo":
+					fmt.Fprint(w, `{"deploymentType": "Cloud"}`)
+				case "/rest/api/2/field":
+					fmt.Fprint(w, test.fields)
+				case "/rest/api/2/search":
+					expectedJQL := `(project = ENG) AND (parent = "ENG-1")`
+					if r.URL.Query().Get("jql") != expectedJQL {
+						t.Errorf("expected JQL %q, got %q", expectedJQL, r.URL.Query().Get("jql"))
+					}
+					fmt.Fprint(w, test.issues)
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			defaultJQL := "project = ENG"
+			d := newTestQueryData(client, jiraConfig{DefaultJQL: &defaultJQL})
+
+			result, err := getEpicProgress(newTestContext(), d, &plugin.HydrateData{Item: EpicProgressInfo{EpicKey: "ENG-1"}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			progress := result.(*EpicProgress)
+
+			if progress.IssueCount != test.expected.IssueCount || progress.DoneCount != test.expected.DoneCount {
+				t.Errorf("expected %d issues and %d done, got %d and %d", test.expected.IssueCount, test.expected.DoneCount, progress.IssueCount, progress.DoneCount)
+			}
+			for name, points := range map[string][2]*float64{
+				"total points": {test.expected.TotalPoints, progress.TotalPoints},
+				"done points":  {test.expected.DonePoints, progress.DonePoints},
+			} {
+				expected, actual := points[0], points[1]
+				if (expected == nil) != (actual == nil) || (expected != nil && *expected != *actual) {
+					t.Errorf("expected %s %v, got %v", name, formatPoints(expected), formatPoints(actual))
+				}
+			}
+		})
+	}
+}
+
+func formatPoints(points *float64) string {
+	if points == nil {
+		return "null"
+	}
+	return fmt.Sprint(*points)
+}
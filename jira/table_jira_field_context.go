@@ -0,0 +1,266 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableFieldContext(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_field_context",
+		Description: "Contexts of custom fields, which scope a field to projects and issue types.",
+		List: &plugin.ListConfig{
+			Hydrate: listFieldContexts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "field_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "field_id",
+				Description: "The ID of the custom field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the context.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Context.Id"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the context.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Context.Name"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the context.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Context.Description").NullIfZero(),
+			},
+			{
+				Name:        "is_global_context",
+				Description: "Whether the context applies to all projects.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Context.IsGlobalContext"),
+			},
+			{
+				Name:        "is_any_issue_type",
+				Description: "Whether the context applies to all issue types.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Context.IsAnyIssueType"),
+			},
+
+			// json fields
+			{
+				Name:        "project_ids",
+				Description: "The IDs of the projects the context applies to. Empty for global contexts.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "issue_type_ids",
+				Description: "The IDs of the issue types the context applies to. Empty if the context applies to any issue type.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Context.Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listFieldContexts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_context.listFieldContexts", "connection_error", err)
+		return nil, err
+	}
+
+	// Without a field_id, the contexts of every custom field are listed,
+	// which takes at least one request per custom field
+	fieldIds := []string{}
+	if d.KeyColumnQualString("field_id") != "" {
+		fieldIds = append(fieldIds, d.KeyColumnQualString("field_id"))
+	} else {
+		fields, _, err := client.Field.GetListWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_context.listFieldContexts", "list_fields_error", err)
+			return nil, err
+		}
+		for _, field := range fields {
+			if field.Custom {
+				fieldIds = append(fieldIds, field.ID)
+			}
+		}
+	}
+
+	for _, fieldId := range fieldIds {
+		done, err := listContextsForField(ctx, d, client, fieldId)
+		if err != nil || done {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// listContextsForField:: streams the contexts of the field, and returns true
+// once no more rows are needed
+func listContextsForField(ctx context.Context, d *plugin.QueryData, client *jira.Client, fieldId string) (bool, error) {
+	var contexts []FieldContext
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/api/3/field/%s/context?startAt=%d&maxResults=%d", url.PathEscape(fieldId), last, getPageSize(d, 100))
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_context.listContextsForField", "get_request_error", err)
+			return false, err
+		}
+
+		listResult := new(ListFieldContextResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			// The field doesn't exist or isn't a custom field
+			if isNotFoundError(err) || isBadRequestError(err) {
+				return false, nil
+			}
+			plugin.Logger(ctx).Error("jira_field_context.listContextsForField", "api_error", err)
+			return false, err
+		}
+
+		contexts = append(contexts, listResult.Values...)
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast {
+			break
+		}
+	}
+
+	// The project and issue type mappings are only fetched if selected
+	projectIds := map[string][]string{}
+	if isColumnRequested(d, "project_ids") {
+		mappings, err := listFieldContextMappings(ctx, d, client, fieldId, "projectmapping")
+		if err != nil {
+			return false, err
+		}
+		for _, mapping := range mappings {
+			if mapping.ProjectId != "" {
+				projectIds[mapping.ContextId] = append(projectIds[mapping.ContextId], mapping.ProjectId)
+			}
+		}
+	}
+
+	issueTypeIds := map[string][]string{}
+	if isColumnRequested(d, "issue_type_ids") {
+		mappings, err := listFieldContextMappings(ctx, d, client, fieldId, "issuetypemapping")
+		if err != nil {
+			return false, err
+		}
+		for _, mapping := range mappings {
+			if mapping.IssueTypeId != "" {
+				issueTypeIds[mapping.ContextId] = append(issueTypeIds[mapping.ContextId], mapping.IssueTypeId)
+			}
+		}
+	}
+
+	for _, fieldContext := range contexts {
+		d.StreamListItem(ctx, FieldContextInfo{fieldId, fieldContext, projectIds[fieldContext.Id], issueTypeIds[fieldContext.Id]})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// listFieldContextMappings:: lists the project or issue type mappings of all
+// the contexts of the field
+func listFieldContextMappings(ctx context.Context, d *plugin.QueryData, client *jira.Client, fieldId string, mappingType string) ([]FieldContextMapping, error) {
+	var mappings []FieldContextMapping
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/api/3/field/%s/context/%s?startAt=%d&maxResults=%d", url.PathEscape(fieldId), mappingType, last, getPageSize(d, 100))
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_context.listFieldContextMappings", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListFieldContextMappingResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				return mappings, nil
+			}
+			plugin.Logger(ctx).Error("jira_field_context.listFieldContextMappings", "api_error", err)
+			return nil, err
+		}
+
+		mappings = append(mappings, listResult.Values...)
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast {
+			return mappings, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListFieldContextResult struct {
+	MaxResults int            `json:"maxResults"`
+	StartAt    int            `json:"startAt"`
+	Total      int            `json:"total"`
+	IsLast     bool           `json:"isLast"`
+	Values     []FieldContext `json:"values"`
+}
+
+type FieldContext struct {
+	Id              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	IsGlobalContext bool   `json:"isGlobalContext"`
+	IsAnyIssueType  bool   `json:"isAnyIssueType"`
+}
+
+type ListFieldContextMappingResult struct {
+	MaxResults int                   `json:"maxResults"`
+	StartAt    int                   `json:"startAt"`
+	Total      int                   `json:"total"`
+	IsLast     bool                  `json:"isLast"`
+	Values     []FieldContextMapping `json:"values"`
+}
+
+// FieldContextMapping is a project or issue type mapping of a context
+type FieldContextMapping struct {
+	ContextId   string `json:"contextId"`
+	ProjectId   string `json:"projectId"`
+	IssueTypeId string `json:"issueTypeId"`
+}
+
+type FieldContextInfo struct {
+	FieldId      string
+	Context      FieldContext
+	ProjectIds   []string
+	IssueTypeIds []string
+}
@@ -75,13 +75,7 @@ func listGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
-	}
+	maxResults := getPageSize(d, 1000)
 	for {
 		apiEndpoint := fmt.Sprintf(
 			"/rest/api/3/group/bulk?startAt=%d&maxResults=%d",
@@ -89,14 +83,14 @@ func listGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 			maxResults,
 		)
 
-		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_group.listGroups", "get_request_error", err)
 			return nil, err
 		}
 
 		listGroupResult := new(ListGroupResult)
-		_, err = client.Do(req, listGroupResult)
+		_, err = doRequest(ctx, client, req, listGroupResult)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_group.listGroups", "api_error", err)
 			return nil, err
@@ -134,13 +128,13 @@ func getGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 	}
 
 	apiEndpoint := fmt.Sprintf("/rest/api/3/group/bulk?groupId=%s", groupId)
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_group.getGroup", "get_request_error", err)
 		return nil, err
 	}
 
-	_, err = client.Do(req, listGroupResult)
+	_, err = doRequest(ctx, client, req, listGroupResult)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_group.getGroup", "api_error", err)
 		return nil, err
@@ -166,13 +160,7 @@ func getGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	last := 0
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
-	}
+	maxResults := getPageSize(d, 1000)
 	for {
 		opts := &jira.GroupSearchOptions{
 			MaxResults:           maxResults,
@@ -180,7 +168,7 @@ func getGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 			IncludeInactiveUsers: true,
 		}
 
-		chunk, resp, err := client.Group.GetWithOptions(group.Name, opts)
+		chunk, resp, err := client.Group.GetWithOptionsWithContext(ctx, group.Name, opts)
 		if err != nil {
 			if isNotFoundError(err) {
 				return groupMembers, nil
@@ -0,0 +1,113 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableGroupPicker(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_group_picker",
+		Description: "Groups whose names match a search query, as suggested by the group picker.",
+		List: &plugin.ListConfig{
+			Hydrate:    listGroupPicker,
+			KeyColumns: plugin.SingleColumn("query"),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_id",
+				Description: "The ID of the group, which uniquely identifies the group across all Atlassian products.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupId"),
+			},
+			{
+				Name:        "html",
+				Description: "The group name with the matched query string highlighted with the HTML bold tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query",
+				Description: "The string to find in group names.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGroupPicker(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	query := d.KeyColumnQualString("query")
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_group_picker.listGroupPicker", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	maxResults := getPageSize(d, 1000)
+
+	// The picker isn't paged, it returns at most maxResults matches
+	apiEndpoint := fmt.Sprintf("/rest/api/2/groups/picker?query=%s&maxResults=%d", url.QueryEscape(query), maxResults)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_group_picker.listGroupPicker", "get_request_error", err)
+		return nil, err
+	}
+
+	pickerResult := new(GroupPickerResult)
+	_, err = doRequest(ctx, client, req, pickerResult)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_group_picker.listGroupPicker", "api_error", err)
+		return nil, err
+	}
+
+	for _, group := range pickerResult.Groups {
+		d.StreamListItem(ctx, group)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type GroupPickerResult struct {
+	Header string        `json:"header"`
+	Total  int           `json:"total"`
+	Groups []GroupPicker `json:"groups"`
+}
+
+type GroupPicker struct {
+	Name    string `json:"name"`
+	Html    string `json:"html"`
+	GroupId string `json:"groupId"`
+}
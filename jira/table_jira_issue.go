@@ -2,7 +2,12 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
@@ -32,6 +37,7 @@ func tableIssue(_ context.Context) *plugin.Table {
 				{Name: "creator_display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "duedate", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
 				{Name: "epic_key", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "jql", Require: plugin.Optional, CacheMatch: "exact"},
 				{Name: "priority", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "project_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "project_key", Require: plugin.Optional, Operators: []string{"=", "<>"}},
@@ -39,9 +45,23 @@ func tableIssue(_ context.Context) *plugin.Table {
 				{Name: "reporter_account_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "reporter_display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "resolution_date", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
+				{Name: "start_at", Require: plugin.Optional, CacheMatch: "exact"},
 				{Name: "status", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "status_category_key", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "type", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "updated", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
+				{Name: "validate_query", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				// Limit concurrency to avoid a 429 too many requests error
+				Func:           getIssueUserEmails,
+				MaxConcurrency: 10,
+			},
+			{
+				Func:           getIssueChangelogTotal,
+				MaxConcurrency: 10,
 			},
 		},
 		Columns: []*plugin.Column{
@@ -80,11 +100,41 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Fields.Status.Name"),
 			},
+			{
+				Name:        "status_category_key",
+				Description: "The key of the category of the status of the issue: new (To Do), indeterminate (In Progress) or done.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Fields.Status.StatusCategory.Key"),
+			},
 			{
 				Name:        "epic_key",
-				Description: "The key of the epic to which issue belongs.",
+				Description: "The key of the epic to which issue belongs. Read from the parent of the issue if it is at the epic level of the issue type hierarchy, otherwise from the Epic Link field (classic projects).",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromP(extractRequiredField, "epic"),
+				Transform:   transform.From(extractEpicKey),
+			},
+			{
+				Name:        "parent_id",
+				Description: "The ID of the parent issue. Only set for subtasks and child issues.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Fields.Parent.ID"),
+			},
+			{
+				Name:        "parent_key",
+				Description: "The key of the parent issue. Only set for subtasks and child issues.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Fields.Parent.Key"),
+			},
+			{
+				Name:        "parent_summary",
+				Description: "The summary of the parent issue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parent.Fields.Summary"),
+			},
+			{
+				Name:        "parent_status_name",
+				Description: "The status of the parent issue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parent.Fields.Status.Name"),
 			},
 			{
 				Name:        "sprint_ids",
@@ -98,6 +148,12 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromP(extractRequiredField, "sprint").Transform(extractSprintNames),
 			},
+			{
+				Name:        "flagged",
+				Description: "True if the issue is flagged, e.g. as blocked. Null if the Flagged field can't be found.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractRequiredField, "flagged").Transform(extractIssueFlagged),
+			},
 
 			// other important fields
 			{
@@ -112,6 +168,13 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Fields.Assignee.DisplayName"),
 			},
+			{
+				Name:        "assignee_email",
+				Description: "Email address of the user/application that the issue is assigned to work. Null if hidden by the user's privacy settings and it can't be looked up.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIssueUserEmails,
+				Transform:   transform.FromP(extractIssueUserEmail, "assignee"),
+			},
 			{
 				Name:        "creator_account_id",
 				Description: "Account Id of the user/application that created the issue.",
@@ -124,6 +187,19 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Fields.Creator.DisplayName"),
 			},
+			{
+				Name:        "changelog_total",
+				Description: "The number of changes made to the issue.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getIssueChangelogTotal,
+				Transform:   transform.From(extractHydrateCount),
+			},
+			{
+				Name:        "comment_count",
+				Description: "The number of comments on the issue that are visible to the user.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CommentTotal"),
+			},
 			{
 				Name:        "created",
 				Description: "Time when the issue was created.",
@@ -178,6 +254,13 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Fields.Reporter.DisplayName"),
 			},
+			{
+				Name:        "reporter_email",
+				Description: "Email address of the user/application issue is reported. Null if hidden by the user's privacy settings and it can't be looked up.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIssueUserEmails,
+				Transform:   transform.FromP(extractIssueUserEmail, "reporter"),
+			},
 			{
 				Name:        "resolution_date",
 				Description: "Date the issue was resolved.",
@@ -196,6 +279,84 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("Fields.Updated").Transform(convertJiraTime),
 			},
+			{
+				Name:        "original_estimate_seconds",
+				Description: "The original estimate of the time needed to resolve the issue, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Fields.TimeTracking.OriginalEstimateSeconds", "Fields.TimeOriginalEstimate").NullIfZero(),
+			},
+			{
+				Name:        "remaining_estimate_seconds",
+				Description: "The remaining estimate of the time needed to resolve the issue, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Fields.TimeTracking.RemainingEstimateSeconds", "Fields.TimeEstimate").NullIfZero(),
+			},
+			{
+				Name:        "time_spent_seconds",
+				Description: "The time spent working on the issue, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Fields.TimeTracking.TimeSpentSeconds", "Fields.TimeSpent").NullIfZero(),
+			},
+			{
+				Name:        "aggregate_original_estimate_seconds",
+				Description: "The original estimate of the issue and its subtasks, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Fields.AggregateTimeOriginalEstimate").NullIfZero(),
+			},
+			{
+				Name:        "aggregate_remaining_estimate_seconds",
+				Description: "The remaining estimate of the issue and its subtasks, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Fields.AggregateTimeEstimate").NullIfZero(),
+			},
+			{
+				Name:        "aggregate_time_spent_seconds",
+				Description: "The time spent working on the issue and its subtasks, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Fields.AggregateTimeSpent").NullIfZero(),
+			},
+			{
+				Name:        "security_level_id",
+				Description: "The ID of the security level of the issue. Null if the issue has no security level.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractIssueSecurityLevel, "id"),
+			},
+			{
+				Name:        "security_level_name",
+				Description: "The name of the security level of the issue. Null if the issue has no security level.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractIssueSecurityLevel, "name"),
+			},
+			{
+				Name:        "subtask_count",
+				Description: "The number of subtasks of the issue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Fields.Subtasks").Transform(countSubtasks),
+			},
+			{
+				Name:        "subtask_done_count",
+				Description: "The number of subtasks of the issue in a done status.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Fields.Subtasks").Transform(countDoneSubtasks),
+			},
+			{
+				Name:        "watch_count",
+				Description: "The number of users watching the issue. Null if disable_watches is set.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(extractIssueWatches).Transform(extractWatchCount),
+			},
+			{
+				Name:        "vote_count",
+				Description: "The number of votes on the issue. Null if voting is disabled or disable_votes is set.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(extractIssueVotes).Transform(extractVoteCount),
+			},
+			{
+				Name:        "has_voted",
+				Description: "Whether the current user has voted on the issue. Null if voting is disabled or disable_votes is set.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(extractIssueVotes).Transform(extractHasVoted),
+			},
 
 			// JSON fields
 			{
@@ -204,17 +365,59 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Fields.Components").Transform(extractComponentIds),
 			},
+			{
+				Name:        "affected_version_names",
+				Description: "The names of the versions affected by the issue.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Fields.AffectsVersions").Transform(extractAffectedVersionNames),
+			},
 			{
 				Name:        "fields",
 				Description: "Json object containing important subfields of the issue.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "jql",
+				Description: "A JQL query to filter the issues with. This is AND-combined with the other filters and the default_jql of the connection. Several queries can be given with jql in (...), returning the issues matching any of them.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JQL").NullIfZero(),
+			},
+			{
+				Name:        "fix_version_names",
+				Description: "The names of the versions the issue is fixed in.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Fields.FixVersions").Transform(extractFixVersionNames),
+			},
+			{
+				Name:        "raw",
+				Description: "The full issue payload returned by the API, including the fields that have no column of their own.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractIssueRaw),
+			},
+			{
+				Name:        "start_at",
+				Description: "The index of the first issue to return. Use it to resume a large export from a saved position.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("start_at"),
+			},
 			{
 				Name:        "tags",
 				Type:        proto.ColumnType_JSON,
 				Description: "A map of label names associated with this issue, in Steampipe standard format.",
 				Transform:   transform.From(getIssueTags),
 			},
+			{
+				Name:        "validate_query",
+				Description: "How the JQL query is validated: strict (the default) returns an error for invalid queries, warn and none run them with the invalid clauses removed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("validate_query"),
+			},
+			{
+				Name:        "watches",
+				Description: "The watch details of the issue, including the watch count and whether the current user is watching it. Null if disable_watches is set.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractIssueWatches),
+			},
 
 			// Standard columns
 			{
@@ -236,56 +439,95 @@ func listIssues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		return nil, err
 	}
 
-	last := 0
+	// Resume paging from the given position, if any
+	start := 0
+	if d.KeyColumnQuals["start_at"] != nil {
+		start = int(d.KeyColumnQuals["start_at"].GetInt64Value())
+	}
 
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var limit int = 50
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 50 {
-			limit = int(*queryLimit)
-		}
+	limit := getPageSize(d, 100)
+
+	jiraConfig := GetConfig(d.Connection)
+	var defaultJQL string
+	if jiraConfig.DefaultJQL != nil {
+		defaultJQL = *jiraConfig.DefaultJQL
 	}
-	options := jira.SearchOptions{
-		StartAt:    0,
-		MaxResults: limit,
-		Expand:     "names",
+
+	// A jql qual with several values, e.g. jql in ('q1', 'q2'), runs each
+	// query in turn and streams the union of the issues
+	userJQLs := getUserJQLs(d.KeyColumnQuals["jql"], d.QueryContext.UnsafeQuals["jql"])
+	if len(userJQLs) == 0 {
+		return nil, nil
 	}
 
-	jql := buildJQLQueryFromQuals(d.Quals, d.Table.Columns)
-	plugin.Logger(ctx).Debug("jira_issue.listIssues", "JQL", jql)
+	// Looser validation runs queries that refer to e.g. unknown values, which
+	// strict validation rejects
+	validateQuery := d.KeyColumnQualString("validate_query")
+	switch validateQuery {
+	case "", "strict", "warn", "none":
+	default:
+		return nil, fmt.Errorf("invalid validate_query %q, must be one of strict, warn or none", validateQuery)
+	}
 
-	for {
-		issues, resp, err := client.Issue.SearchWithContext(ctx, jql, &options)
+	disableVotes := isVotingDisabled(d)
+	disableWatches := isWatchingDisabled(d)
+	rawRequested := isColumnRequested(d, "raw")
+	emailsRequested := isColumnRequested(d, "assignee_email") || isColumnRequested(d, "reporter_email")
 
-		if err != nil {
-			if isNotFoundError(err) || strings.Contains(err.Error(), "400") {
-				return nil, nil
-			}
-			plugin.Logger(ctx).Error("jira_issue.listIssues", "api_error", err)
-			return nil, err
+	// The time zone is only looked up if there are timestamp quals to push down
+	var location *time.Location
+	if hasTimestampQual(d.Quals, d.Table.Columns) {
+		location = getJQLTimeLocation(ctx, d)
+	}
+
+	search := issueSearch{
+		DefaultJQL:    defaultJQL,
+		QualsJQL:      buildJQLQueryFromQuals(d.Quals, d.Table.Columns, location),
+		ValidateQuery: validateQuery,
+		StartAt:       start,
+		MaxResults:    limit,
+	}
+	// The comments aren't navigable, so they are only returned if asked for.
+	// Their total is then read from the search results, rather than once
+	// per issue.
+	if isColumnRequested(d, "comment_count") {
+		search.Fields = []string{"*navigable", "comment"}
+	}
+
+	err = search.run(ctx, client, userJQLs, func(listResult *SearchIssuesResult, userJQL string) bool {
+		keys := map[string]string{
+			"epic":    getFieldKey(ctx, d, listResult.Names, "Epic Link"),
+			"sprint":  getFieldKey(ctx, d, listResult.Names, "Sprint"),
+			"flagged": getFlaggedFieldKey(ctx, d, listResult.Names),
 		}
 
-		for _, issue := range issues {
-			keys := map[string]string{
-				"epic":   getFieldKey(ctx, d, issue.Names, "Epic Link"),
-				"sprint": getFieldKey(ctx, d, issue.Names, "Sprint"),
+		// Look up the missing email addresses of the whole page at once,
+		// rather than once per issue
+		if emailsRequested {
+			prefetchIssueUserEmails(ctx, d, listResult.Issues)
+		}
+
+		for _, issue := range listResult.Issues {
+			issueInfo := IssueInfo{Issue: issue.Issue, Parent: issue.Parent, CommentTotal: issue.CommentTotal, Keys: keys, JQL: userJQL, DisableVotes: disableVotes, DisableWatches: disableWatches}
+			// Only keep the payload if it is selected, as it can be large
+			if rawRequested {
+				issueInfo.Raw = issue.Raw
 			}
-			d.StreamListItem(ctx, IssueInfo{issue, keys})
+			d.StreamListItem(ctx, issueInfo)
 			// Context may get cancelled due to manual cancellation or if the limit has been reached
 			if d.QueryStatus.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				return false
 			}
 		}
-
-		last = resp.StartAt + len(issues)
-		if last >= resp.Total {
-			return nil, nil
-		} else {
-			options.StartAt = last
-		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	return nil, nil
 }
 
 //// HYDRATE FUNCTION
@@ -312,9 +554,15 @@ func getIssue(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 		return nil, nil
 	}
 
-	issue, _, err := client.Issue.Get(id, &jira.GetQueryOptions{
-		Expand: "names",
-	})
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s?expand=names", url.PathEscape(id))
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue.getIssue", "get_request_error", err)
+		return nil, err
+	}
+
+	issue := new(IssueResult)
+	_, err = doRequest(ctx, client, req, issue)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -325,17 +573,122 @@ func getIssue(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 
 	epicKey := getFieldKey(ctx, d, issue.Names, "Epic Link")
 	sprintKey := getFieldKey(ctx, d, issue.Names, "Sprint")
+	flaggedKey := getFlaggedFieldKey(ctx, d, issue.Names)
 
 	keys := map[string]string{
-		"epic":   epicKey,
-		"sprint": sprintKey,
+		"epic":    epicKey,
+		"sprint":  sprintKey,
+		"flagged": flaggedKey,
+	}
+
+	return IssueInfo{Issue: issue.Issue, Parent: issue.Parent, CommentTotal: issue.CommentTotal, Keys: keys, Raw: issue.Raw, DisableVotes: isVotingDisabled(d), DisableWatches: isWatchingDisabled(d)}, err
+}
+
+// getIssueUserEmails:: returns the email addresses of the assignee and the
+// reporter of the issue. Addresses missing from the issue, e.g. due to
+// privacy settings, are looked up with the bulk email endpoint. When listing
+// issues, they have already been looked up for the whole page by
+// prefetchIssueUserEmails, so they are read from the cache.
+func getIssueUserEmails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	issue := h.Item.(IssueInfo)
+	if issue.Fields == nil {
+		return nil, nil
+	}
+
+	userEmails, err := getUserEmails(ctx, d, getIssueUsersWithoutEmail(issue.Fields))
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue.getIssueUserEmails", "api_error", err)
+		return nil, err
 	}
 
-	return IssueInfo{*issue, keys}, err
+	emails := map[string]string{}
+	for role, user := range map[string]*jira.User{"assignee": issue.Fields.Assignee, "reporter": issue.Fields.Reporter} {
+		if user == nil {
+			continue
+		}
+		if user.EmailAddress != "" {
+			emails[role] = user.EmailAddress
+		} else {
+			emails[role] = userEmails[user.AccountID]
+		}
+	}
+
+	return emails, nil
+}
+
+// prefetchIssueUserEmails:: looks up the missing email addresses of the
+// assignees and reporters of a page of issues in as few bulk requests as
+// possible, caching them for getIssueUserEmails
+func prefetchIssueUserEmails(ctx context.Context, d *plugin.QueryData, issues []IssueResult) {
+	var accountIds []string
+	for _, issue := range issues {
+		if issue.Fields != nil {
+			accountIds = append(accountIds, getIssueUsersWithoutEmail(issue.Fields)...)
+		}
+	}
+	if len(accountIds) == 0 {
+		return
+	}
+
+	// getIssueUserEmails looks them up again if this fails
+	if _, err := getUserEmails(ctx, d, accountIds); err != nil {
+		plugin.Logger(ctx).Warn("jira_issue.prefetchIssueUserEmails", "api_error", err)
+	}
+}
+
+// getIssueUsersWithoutEmail:: returns the account IDs of the assignee and
+// the reporter of the issue, if their email address isn't in the issue
+func getIssueUsersWithoutEmail(fields *jira.IssueFields) []string {
+	var accountIds []string
+	for _, user := range []*jira.User{fields.Assignee, fields.Reporter} {
+		if user != nil && user.EmailAddress == "" && user.AccountID != "" {
+			accountIds = append(accountIds, user.AccountID)
+		}
+	}
+	return accountIds
+}
+
+func getIssueChangelogTotal(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	issue := h.Item.(IssueInfo)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue.getIssueChangelogTotal", "connection_error", err)
+		return nil, err
+	}
+
+	// Only the total is needed, so no changelog items are returned
+	apiEndpoint := fmt.Sprintf("/rest/api/3/issue/%s/changelog?maxResults=0", url.PathEscape(issue.Key))
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue.getIssueChangelogTotal", "get_request_error", err)
+		return nil, err
+	}
+
+	result := new(IssueChangelogTotal)
+	_, err = doRequest(ctx, client, req, result)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue.getIssueChangelogTotal", "api_error", err)
+		return nil, err
+	}
+
+	return result.Total, nil
 }
 
 //// TRANSFORM FUNCTION
 
+// extractHydrateCount:: returns the count returned by the hydrate function,
+// or null if it returned nothing, e.g. because the issue was deleted
+func extractHydrateCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if count, ok := d.HydrateItem.(int64); ok {
+		return count, nil
+	}
+	return nil, nil
+}
+
 func extractComponentIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	var componentIds []string
 	for _, item := range d.Value.([]*jira.Component) {
@@ -344,6 +697,24 @@ func extractComponentIds(_ context.Context, d *transform.TransformData) (interfa
 	return componentIds, nil
 }
 
+func extractFixVersionNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	versions, _ := d.Value.([]*jira.FixVersion)
+	var versionNames []string
+	for _, item := range versions {
+		versionNames = append(versionNames, item.Name)
+	}
+	return versionNames, nil
+}
+
+func extractAffectedVersionNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	versions, _ := d.Value.([]*jira.AffectsVersion)
+	var versionNames []string
+	for _, item := range versions {
+		versionNames = append(versionNames, item.Name)
+	}
+	return versionNames, nil
+}
+
 func extractRequiredField(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	issueInfo := d.HydrateItem.(IssueInfo)
 	m := issueInfo.Fields.Unknowns
@@ -351,6 +722,39 @@ func extractRequiredField(_ context.Context, d *transform.TransformData) (interf
 	return m[issueInfo.Keys[param]], nil
 }
 
+// epicHierarchyLevel is the hierarchy level of epics, whatever their issue
+// type is called
+const epicHierarchyLevel = 1
+
+// extractEpicKey:: returns the key of the epic of the issue. In next-gen
+// projects the epic is the parent of the issue, while classic projects use
+// the Epic Link custom field. The parent is taken as the epic by its
+// hierarchy level rather than its name, which can be renamed or localized.
+// Server doesn't report levels, and only subtasks have parents there.
+func extractEpicKey(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	issueInfo := d.HydrateItem.(IssueInfo)
+	if parent := issueInfo.Parent; parent != nil && parent.Fields.Type != nil && parent.Fields.Type.HierarchyLevel != nil && *parent.Fields.Type.HierarchyLevel == epicHierarchyLevel {
+		return parent.Key, nil
+	}
+
+	if issueInfo.Fields == nil || issueInfo.Keys["epic"] == "" {
+		return nil, nil
+	}
+	return issueInfo.Fields.Unknowns[issueInfo.Keys["epic"]], nil
+}
+
+// extractIssueFlagged:: the Flagged field holds the selected options, e.g.
+// [{"value": "Impediment"}], and is empty or null when the flag is cleared
+func extractIssueFlagged(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	issueInfo := d.HydrateItem.(IssueInfo)
+	if issueInfo.Keys["flagged"] == "" {
+		return nil, nil
+	}
+
+	options, _ := d.Value.([]interface{})
+	return len(options) > 0, nil
+}
+
 func extractSprintIds(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	if d.Value == nil {
 		return nil, nil
@@ -376,6 +780,108 @@ func extractSprintNames(ctx context.Context, d *transform.TransformData) (interf
 	return sprintNames, nil
 }
 
+func extractIssueUserEmail(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	emails, ok := d.HydrateItem.(map[string]string)
+	if !ok || emails[d.Param.(string)] == "" {
+		return nil, nil
+	}
+	return emails[d.Param.(string)], nil
+}
+
+func countSubtasks(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subtasks, _ := d.Value.([]*jira.Subtasks)
+	return len(subtasks), nil
+}
+
+func countDoneSubtasks(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subtasks, _ := d.Value.([]*jira.Subtasks)
+	count := 0
+	for _, subtask := range subtasks {
+		if subtask.Fields.Status != nil && subtask.Fields.Status.StatusCategory.Key == "done" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func extractIssueRaw(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	issue := d.HydrateItem.(IssueInfo)
+	if len(issue.Raw) == 0 {
+		return nil, nil
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(issue.Raw, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// extractIssueSecurityLevel:: returns a property of the security level of
+// the issue, which go-jira doesn't decode, so it is read from the unknown
+// fields
+func extractIssueSecurityLevel(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	issue := d.HydrateItem.(IssueInfo)
+	if issue.Fields == nil {
+		return nil, nil
+	}
+
+	// The field is missing if the issue has no security level
+	security, ok := issue.Fields.Unknowns["security"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	return security[d.Param.(string)], nil
+}
+
+// extractIssueVotes:: returns the votes field of the issue, which go-jira
+// doesn't decode, so it is read from the unknown fields
+func extractIssueVotes(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	issue := d.HydrateItem.(IssueInfo)
+	if issue.DisableVotes || issue.Fields == nil {
+		return nil, nil
+	}
+
+	// The field is missing if voting is disabled
+	votes, ok := issue.Fields.Unknowns["votes"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	return votes, nil
+}
+
+func extractVoteCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Value == nil {
+		return nil, nil
+	}
+	return d.Value.(map[string]interface{})["votes"], nil
+}
+
+func extractHasVoted(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Value == nil {
+		return nil, nil
+	}
+	return d.Value.(map[string]interface{})["hasVoted"], nil
+}
+
+// extractIssueWatches:: returns the watches of the issue, or nil if
+// disable_watches is set
+func extractIssueWatches(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	issue := d.HydrateItem.(IssueInfo)
+	if issue.DisableWatches || issue.Fields == nil || issue.Fields.Watches == nil {
+		return nil, nil
+	}
+	return issue.Fields.Watches, nil
+}
+
+func extractWatchCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	watches, ok := d.Value.(*jira.Watches)
+	if !ok || watches == nil {
+		return nil, nil
+	}
+	return watches.WatchCount, nil
+}
+
 func getIssueTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	issue := d.HydrateItem.(IssueInfo)
 
@@ -406,8 +912,150 @@ func getFieldKey(ctx context.Context, d *plugin.QueryData, names map[string]stri
 	return ""
 }
 
+// getUserJQLs:: returns the queries given with the jql qual. The SDK runs a
+// list call per value of jql in (...), each seeing a single value, so the
+// call for the first value runs all of the queries, which lets issues
+// matched by several of them be streamed once, and the other calls return
+// nothing.
+func getUserJQLs(qual *proto.QualValue, unsafeQuals *proto.Quals) []string {
+	if qual == nil {
+		return []string{""}
+	}
+	if listValue := qual.GetListValue(); listValue != nil {
+		return getQualStringValues(listValue)
+	}
+
+	value := qual.GetStringValue()
+	for _, unsafeQual := range unsafeQuals.GetQuals() {
+		listValue := unsafeQual.GetValue().GetListValue()
+		if listValue == nil {
+			continue
+		}
+		values := getQualStringValues(listValue)
+		for i, v := range values {
+			if v != value {
+				continue
+			}
+			if i == 0 {
+				return values
+			}
+			return nil
+		}
+	}
+
+	return []string{value}
+}
+
+func getQualStringValues(listValue *proto.QualValueList) []string {
+	var values []string
+	for _, value := range listValue.GetValues() {
+		values = append(values, value.GetStringValue())
+	}
+	return values
+}
+
+// getFlaggedFieldKey:: get key of the Flagged field, as set by flagged_field
+// or else looked up by name
+func getFlaggedFieldKey(ctx context.Context, d *plugin.QueryData, names map[string]string) string {
+	jiraConfig := GetConfig(d.Connection)
+	if jiraConfig.FlaggedField != nil {
+		return *jiraConfig.FlaggedField
+	}
+	return getFieldKey(ctx, d, names, "Flagged")
+}
+
 //// Required Structs
 
+// issueSearch runs the searches of a jira_issue list call
+type issueSearch struct {
+	DefaultJQL    string
+	QualsJQL      string
+	ValidateQuery string
+	StartAt       int
+	MaxResults    int
+	// Fields replaces the default navigable fields returned for each issue
+	Fields []string
+}
+
+// run searches the issues matching each of the given queries, AND-combined
+// with the default and qual JQL, and calls streamPage with each page of
+// issues. An issue matching several of the queries is only passed once,
+// with the first query it matched. Paging stops when streamPage returns false.
+func (s issueSearch) run(ctx context.Context, client *jira.Client, userJQLs []string, streamPage func(*SearchIssuesResult, string) bool) error {
+	seen := map[string]bool{}
+
+	for _, userJQL := range userJQLs {
+		jql, err := combineJQL(s.DefaultJQL, userJQL, s.QualsJQL)
+		if err != nil {
+			return err
+		}
+		plugin.Logger(ctx).Debug("jira_issue.listIssues", "JQL", jql)
+
+		last := s.StartAt
+		for {
+			params := url.Values{}
+			params.Set("jql", jql)
+			params.Set("startAt", strconv.Itoa(last))
+			params.Set("maxResults", strconv.Itoa(s.MaxResults))
+			params.Set("expand", "names")
+			if s.ValidateQuery != "" {
+				params.Set("validateQuery", s.ValidateQuery)
+			}
+			if len(s.Fields) > 0 {
+				params.Set("fields", strings.Join(s.Fields, ","))
+			}
+
+			// The issues are decoded into IssueResult rather than using
+			// client.Issue.SearchWithContext, so that the parent details are kept
+			apiEndpoint := fmt.Sprintf("/rest/api/2/search?%s", params.Encode())
+
+			req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+			if err != nil {
+				plugin.Logger(ctx).Error("jira_issue.listIssues", "get_request_error", err)
+				return err
+			}
+
+			listResult := new(SearchIssuesResult)
+			res, err := doRequest(ctx, client, req, listResult)
+			if err != nil {
+				// Report problems with the JQL, whether written by the user or
+				// built from the quals, rather than returning no rows
+				if isBadRequestError(err) {
+					plugin.Logger(ctx).Error("jira_issue.listIssues", "jql_error", err, "jql", jql)
+					return fmt.Errorf("invalid JQL %q: %s", jql, getJiraErrorMessage(res, err))
+				}
+				if isNotFoundError(err) {
+					break
+				}
+				plugin.Logger(ctx).Error("jira_issue.listIssues", "api_error", err)
+				return err
+			}
+
+			last = listResult.StartAt + len(listResult.Issues)
+			total := listResult.Total
+
+			issues := listResult.Issues[:0]
+			for _, issue := range listResult.Issues {
+				if !seen[issue.ID] {
+					seen[issue.ID] = true
+					issues = append(issues, issue)
+				}
+			}
+			listResult.Issues = issues
+
+			if !streamPage(listResult, userJQL) {
+				return nil
+			}
+
+			if last >= total {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 type ListIssuesResult struct {
 	Expand     string            `json:"expand"`
 	MaxResults int               `json:"maxResults"`
@@ -417,7 +1065,84 @@ type ListIssuesResult struct {
 	Names      map[string]string `json:"names,omitempty" structs:"names,omitempty"`
 }
 
+type SearchIssuesResult struct {
+	Expand     string            `json:"expand"`
+	MaxResults int               `json:"maxResults"`
+	StartAt    int               `json:"startAt"`
+	Total      int               `json:"total"`
+	Issues     []IssueResult     `json:"issues"`
+	Names      map[string]string `json:"names,omitempty"`
+}
+
+// IssueResult decodes an issue the same way as jira.Issue, but also keeps the
+// parent issue fields, which jira.IssueFields reduces to the id and key, the
+// total of the comments, which jira.Comments doesn't include, and the raw
+// payload.
+type IssueResult struct {
+	jira.Issue
+	Parent       *IssueParent
+	CommentTotal *int64
+	Raw          json.RawMessage
+}
+
+func (i *IssueResult) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &i.Issue); err != nil {
+		return err
+	}
+
+	// Keep the payload for the raw column, data may be reused by the caller
+	i.Raw = append(json.RawMessage{}, data...)
+
+	parentResult := struct {
+		Fields struct {
+			Parent  *IssueParent `json:"parent"`
+			Comment *struct {
+				Total int64 `json:"total"`
+			} `json:"comment"`
+		} `json:"fields"`
+	}{}
+	if err := json.Unmarshal(data, &parentResult); err != nil {
+		return err
+	}
+	i.Parent = parentResult.Fields.Parent
+	if parentResult.Fields.Comment != nil {
+		i.CommentTotal = &parentResult.Fields.Comment.Total
+	}
+
+	return nil
+}
+
+type IssueChangelogTotal struct {
+	Total int64 `json:"total"`
+}
+
+type IssueParent struct {
+	ID     string            `json:"id"`
+	Key    string            `json:"key"`
+	Self   string            `json:"self"`
+	Fields IssueParentFields `json:"fields"`
+}
+
+type IssueParentFields struct {
+	Summary string           `json:"summary"`
+	Status  *jira.Status     `json:"status"`
+	Type    *IssueParentType `json:"issuetype"`
+}
+
+// IssueParentType adds the hierarchy level, which jira.IssueType doesn't
+// include
+type IssueParentType struct {
+	jira.IssueType
+	HierarchyLevel *int32 `json:"hierarchyLevel"`
+}
+
 type IssueInfo struct {
 	jira.Issue
-	Keys map[string]string
+	Parent         *IssueParent
+	CommentTotal   *int64
+	Keys           map[string]string
+	JQL            string
+	Raw            json.RawMessage
+	DisableVotes   bool
+	DisableWatches bool
 }
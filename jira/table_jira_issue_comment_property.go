@@ -0,0 +1,177 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueCommentProperty(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_comment_property",
+		Description: "Custom properties stored against an issue comment by apps.",
+		List: &plugin.ListConfig{
+			Hydrate: listIssueCommentProperties,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "issue_key", Require: plugin.Required},
+				{Name: "comment_id", Require: plugin.Required},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				// Limit concurrency to avoid a 429 too many requests error
+				Func:           getIssueCommentProperty,
+				MaxConcurrency: 10,
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue the comment belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment_id",
+				Description: "The ID of the comment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the property.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "value",
+				Description: "The value of the property.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIssueCommentProperty,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueCommentProperties(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	issueKey := d.KeyColumnQualString("issue_key")
+	commentId := d.KeyColumnQualString("comment_id")
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_comment_property.listIssueCommentProperties", "connection_error", err)
+		return nil, err
+	}
+
+	// Comment properties are addressed by the comment ID alone, so check that
+	// the comment belongs to the issue first. Jira returns a 404 if it doesn't
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/comment/%s", url.PathEscape(issueKey), url.PathEscape(commentId))
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_comment_property.listIssueCommentProperties", "get_request_error", err)
+		return nil, err
+	}
+
+	comment := new(IssueCommentId)
+	_, err = doRequest(ctx, client, req, comment)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_comment_property.listIssueCommentProperties", "get_comment_error", err)
+		return nil, err
+	}
+
+	apiEndpoint = fmt.Sprintf("/rest/api/2/comment/%s/properties", url.PathEscape(commentId))
+
+	req, err = client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_comment_property.listIssueCommentProperties", "get_request_error", err)
+		return nil, err
+	}
+
+	propertyKeys := new(EntityPropertyKeys)
+	_, err = doRequest(ctx, client, req, propertyKeys)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_comment_property.listIssueCommentProperties", "api_error", err)
+		return nil, err
+	}
+
+	for _, propertyKey := range propertyKeys.Keys {
+		d.StreamListItem(ctx, IssueCommentPropertyInfo{issueKey, commentId, propertyKey.Key})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIssueCommentProperty(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	property := h.Item.(IssueCommentPropertyInfo)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_comment_property.getIssueCommentProperty", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf(
+		"/rest/api/2/comment/%s/properties/%s",
+		url.PathEscape(property.CommentId),
+		url.PathEscape(property.Key),
+	)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_comment_property.getIssueCommentProperty", "get_request_error", err)
+		return nil, err
+	}
+
+	result := new(EntityProperty)
+	_, err = doRequest(ctx, client, req, result)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_comment_property.getIssueCommentProperty", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//// Custom Structs
+
+type IssueCommentId struct {
+	ID string `json:"id"`
+}
+
+type IssueCommentPropertyInfo struct {
+	IssueKey  string
+	CommentId string
+	Key       string
+}
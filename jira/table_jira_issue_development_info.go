@@ -0,0 +1,254 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueDevelopmentInfo(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_development_info",
+		Description: "Repositories, commits, branches and pull requests linked to an issue by a development tool integration.",
+		List: &plugin.ListConfig{
+			Hydrate: listIssueDevelopmentInfo,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "issue_key", Require: plugin.Required},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The application type of the integration, e.g. GitHub, bitbucket or GitLab. Defaults to GitHub.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository",
+				Description: "The name of the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_url",
+				Description: "The URL of the repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RepositoryUrl").NullIfZero(),
+			},
+			{
+				Name:        "commit_count",
+				Description: "The number of commits in the repository that reference the issue.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "branch_count",
+				Description: "The number of branches in the repository that reference the issue.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "pull_request_count",
+				Description: "The number of pull requests in the repository that reference the issue.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// json fields
+			{
+				Name:        "details",
+				Description: "The commits, branches and pull requests of the repository that reference the issue.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Repository"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueDevelopmentInfo(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	issueKey := d.KeyColumnQualString("issue_key")
+	applicationType := d.KeyColumnQualString("type")
+	if applicationType == "" {
+		applicationType = "GitHub"
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_development_info.listIssueDevelopmentInfo", "connection_error", err)
+		return nil, err
+	}
+
+	// The dev-status endpoint takes the numeric issue ID rather than the key
+	issue, _, err := client.Issue.GetWithContext(ctx, issueKey, &jira.GetQueryOptions{Fields: "id"})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_development_info.listIssueDevelopmentInfo", "get_issue_error", err)
+		return nil, err
+	}
+
+	// Group the commits, branches and pull requests by repository
+	repositories := map[string]*IssueDevelopmentInfo{}
+	var repositoryNames []string
+	getRepository := func(name string) *IssueDevelopmentInfo {
+		if repositories[name] == nil {
+			repositories[name] = &IssueDevelopmentInfo{IssueKey: issueKey, Type: applicationType, Repository: name}
+			repositoryNames = append(repositoryNames, name)
+		}
+		return repositories[name]
+	}
+
+	for _, dataType := range []string{"repository", "branch", "pullrequest"} {
+		detail, err := getDevStatusDetail(ctx, client, issue.ID, applicationType, dataType)
+		if err != nil {
+			// dev-status is an internal endpoint, which isn't available on
+			// every deployment or without a development tool integration.
+			// Keep what the other data types returned
+			if isNotFoundError(err) || isForbiddenError(err) || isBadRequestError(err) {
+				plugin.Logger(ctx).Warn("jira_issue_development_info.listIssueDevelopmentInfo", "data_type", dataType, "unavailable", err)
+				continue
+			}
+			plugin.Logger(ctx).Error("jira_issue_development_info.listIssueDevelopmentInfo", "api_error", err)
+			return nil, err
+		}
+
+		for _, instance := range detail.Detail {
+			for _, repository := range instance.Repositories {
+				info := getRepository(repository.Name)
+				info.RepositoryUrl = repository.Url
+				info.CommitCount += len(repository.Commits)
+				info.Details.Commits = append(info.Details.Commits, repository.Commits...)
+			}
+			for _, branch := range instance.Branches {
+				info := getRepository(branch.Repository.Name)
+				info.BranchCount++
+				info.Details.Branches = append(info.Details.Branches, branch.Raw)
+			}
+			for _, pullRequest := range instance.PullRequests {
+				info := getRepository(pullRequest.RepositoryName)
+				info.PullRequestCount++
+				info.Details.PullRequests = append(info.Details.PullRequests, pullRequest.Raw)
+			}
+		}
+	}
+
+	for _, name := range repositoryNames {
+		d.StreamListItem(ctx, repositories[name])
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getDevStatusDetail(ctx context.Context, client *jira.Client, issueId string, applicationType string, dataType string) (*DevStatusDetailResult, error) {
+	apiEndpoint := fmt.Sprintf(
+		"/rest/dev-status/1.0/issue/detail?issueId=%s&applicationType=%s&dataType=%s",
+		url.QueryEscape(issueId),
+		url.QueryEscape(applicationType),
+		url.QueryEscape(dataType),
+	)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(DevStatusDetailResult)
+	_, err = doRequest(ctx, client, req, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//// Custom Structs
+
+type DevStatusDetailResult struct {
+	Errors []interface{}     `json:"errors"`
+	Detail []DevStatusDetail `json:"detail"`
+}
+
+type DevStatusDetail struct {
+	Repositories []DevStatusRepository  `json:"repositories"`
+	Branches     []DevStatusBranch      `json:"branches"`
+	PullRequests []DevStatusPullRequest `json:"pullRequests"`
+}
+
+type DevStatusRepository struct {
+	Name    string        `json:"name"`
+	Url     string        `json:"url"`
+	Commits []interface{} `json:"commits"`
+}
+
+// DevStatusBranch keeps the raw branch, and decodes the repository name to
+// group the branches by
+type DevStatusBranch struct {
+	Raw        map[string]interface{}
+	Repository struct {
+		Name string `json:"name"`
+	} `json:"repository"`
+}
+
+func (b *DevStatusBranch) UnmarshalJSON(data []byte) error {
+	type branch DevStatusBranch
+	if err := json.Unmarshal(data, (*branch)(b)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &b.Raw)
+}
+
+// DevStatusPullRequest keeps the raw pull request, and decodes the
+// repository name to group the pull requests by
+type DevStatusPullRequest struct {
+	Raw            map[string]interface{}
+	RepositoryName string `json:"repositoryName"`
+}
+
+func (p *DevStatusPullRequest) UnmarshalJSON(data []byte) error {
+	type pullRequest DevStatusPullRequest
+	if err := json.Unmarshal(data, (*pullRequest)(p)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &p.Raw)
+}
+
+type IssueDevelopmentInfo struct {
+	IssueKey         string
+	Type             string
+	Repository       string
+	RepositoryUrl    string
+	CommitCount      int
+	BranchCount      int
+	PullRequestCount int
+	Details          IssueDevelopmentDetails
+}
+
+type IssueDevelopmentDetails struct {
+	Commits      []interface{} `json:"commits"`
+	Branches     []interface{} `json:"branches"`
+	PullRequests []interface{} `json:"pull_requests"`
+}
@@ -0,0 +1,113 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueLabel(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_label",
+		Description: "The labels of issues, with a row for each label of each issue.",
+		List: &plugin.ListConfig{
+			Hydrate: listIssueLabels,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "jql", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "label",
+				Description: "The label.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "jql",
+				Description: "A JQL query to filter the issues with. This is AND-combined with the default_jql of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("jql"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Label"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueLabels(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_label.listIssueLabels", "connection_error", err)
+		return nil, err
+	}
+
+	jiraConfig := GetConfig(d.Connection)
+	var defaultJQL string
+	if jiraConfig.DefaultJQL != nil {
+		defaultJQL = *jiraConfig.DefaultJQL
+	}
+
+	// Only the labels are needed, which keeps the response small
+	options := jira.SearchOptions{
+		StartAt:    0,
+		MaxResults: getPageSize(d, 100),
+		Fields:     []string{"labels"},
+	}
+
+	jql, err := combineJQL(defaultJQL, d.KeyColumnQualString("jql"))
+	if err != nil {
+		return nil, err
+	}
+	for {
+		issues, resp, err := client.Issue.SearchWithContext(ctx, jql, &options)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_label.listIssueLabels", "api_error", err)
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			if issue.Fields == nil {
+				continue
+			}
+			for _, label := range issue.Fields.Labels {
+				d.StreamListItem(ctx, IssueLabelInfo{issue.Key, label})
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		last := resp.StartAt + len(issues)
+		if last >= resp.Total {
+			return nil, nil
+		}
+		options.StartAt = last
+	}
+}
+
+//// Custom Structs
+
+type IssueLabelInfo struct {
+	IssueKey string
+	Label    string
+}
@@ -0,0 +1,203 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueProperty(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_property",
+		Description: "Entity properties stored against an issue, typically by apps.",
+		List: &plugin.ListConfig{
+			Hydrate: listIssueProperties,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "issue_key", Require: plugin.AnyOf},
+				{Name: "jql", Require: plugin.AnyOf, CacheMatch: "exact"},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				// Limit concurrency to avoid a 429 too many requests error
+				Func:           getIssueProperty,
+				MaxConcurrency: 10,
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the property.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "jql",
+				Description: "The JQL query used to enumerate the issues.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("jql"),
+			},
+
+			// json fields
+			{
+				Name:        "value",
+				Description: "The value of the property.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIssueProperty,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueProperties(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_property.listIssueProperties", "connection_error", err)
+		return nil, err
+	}
+
+	issueKey := d.KeyColumnQualString("issue_key")
+	jql := d.KeyColumnQualString("jql")
+	if jql == "" {
+		_, err = listPropertiesForIssue(ctx, d, client, issueKey)
+		return nil, err
+	}
+
+	jiraConfig := GetConfig(d.Connection)
+	var defaultJQL string
+	if jiraConfig.DefaultJQL != nil {
+		defaultJQL = *jiraConfig.DefaultJQL
+	}
+
+	// If an issue is also given, only search for that issue
+	var keyJQL string
+	if issueKey != "" {
+		keyJQL = fmt.Sprintf("key = \"%s\"", issueKey)
+	}
+
+	jql, err = combineJQL(defaultJQL, jql, keyJQL)
+	if err != nil {
+		return nil, err
+	}
+
+	options := jira.SearchOptions{
+		StartAt:    0,
+		MaxResults: getPageSize(d, 100),
+		Fields:     []string{"key"},
+	}
+
+	for {
+		issues, resp, err := client.Issue.SearchWithContext(ctx, jql, &options)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_property.listIssueProperties", "api_error", err)
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			done, err := listPropertiesForIssue(ctx, d, client, issue.Key)
+			if err != nil || done {
+				return nil, err
+			}
+		}
+
+		last := resp.StartAt + len(issues)
+		if last >= resp.Total {
+			return nil, nil
+		}
+		options.StartAt = last
+	}
+}
+
+// listPropertiesForIssue streams the property keys of a single issue and
+// reports whether the query limit has been reached
+func listPropertiesForIssue(ctx context.Context, d *plugin.QueryData, client *jira.Client, issueKey string) (bool, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/properties", url.PathEscape(issueKey))
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_property.listPropertiesForIssue", "get_request_error", err)
+		return false, err
+	}
+
+	// Issues without any properties return an empty list of keys
+	propertyKeys := new(EntityPropertyKeys)
+	_, err = doRequest(ctx, client, req, propertyKeys)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_property.listPropertiesForIssue", "api_error", err)
+		return false, err
+	}
+
+	for _, propertyKey := range propertyKeys.Keys {
+		d.StreamListItem(ctx, IssuePropertyInfo{issueKey, propertyKey.Key})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIssueProperty(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	property := h.Item.(IssuePropertyInfo)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_property.getIssueProperty", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/properties/%s", url.PathEscape(property.IssueKey), url.PathEscape(property.Key))
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_property.getIssueProperty", "get_request_error", err)
+		return nil, err
+	}
+
+	result := new(EntityProperty)
+	_, err = doRequest(ctx, client, req, result)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_property.getIssueProperty", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//// Custom Structs
+
+type IssuePropertyInfo struct {
+	IssueKey string
+	Key      string
+}
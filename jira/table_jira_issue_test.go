@@ -0,0 +1,842 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/quals"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func TestIssueSearchStreamsIssuesMatchedBySeveralQueriesOnce(t *testing.T) {
+	// Issue 2 matches both queries
+	results := map[string]string{
+		"(project = A)": `{"startAt": 0, "total": 2, "issues": [{"id": "1", "key": "A-1"}, {"id": "2", "key": "A-2"}]}`,
+		"(project = B)": `{"startAt": 0, "total": 2, "issues": [{"id": "2", "key": "A-2"}, {"id": "3", "key": "B-1"}]}`,
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		result, ok := results[r.URL.Query().Get("jql")]
+		if !ok {
+			t.Errorf("unexpected JQL %q", r.URL.Query().Get("jql"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, result)
+	})
+
+	streamed := map[string][]string{}
+	search := issueSearch{MaxResults: 100}
+	err := search.run(newTestContext(), client, []string{"project = A", "project = B"}, func(page *SearchIssuesResult, userJQL string) bool {
+		for _, issue := range page.Issues {
+			streamed[issue.Key] = append(streamed[issue.Key], userJQL)
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string][]string{
+		"A-1": {"project = A"},
+		"A-2": {"project = A"},
+		"B-1": {"project = B"},
+	}
+	if !reflect.DeepEqual(streamed, expected) {
+		t.Errorf("expected %v, got %v", expected, streamed)
+	}
+}
+
+func TestGetUserJQLs(t *testing.T) {
+	stringValue := func(s string) *proto.QualValue {
+		return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: s}}
+	}
+	listValue := &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: &proto.QualValueList{
+		Values: []*proto.QualValue{stringValue("project = A"), stringValue("project = B")},
+	}}}
+	listQuals := &proto.Quals{Quals: []*proto.Qual{{FieldName: "jql", Value: listValue}}}
+
+	tests := []struct {
+		name        string
+		qual        *proto.QualValue
+		unsafeQuals *proto.Quals
+		expected    []string
+	}{
+		{"no qual", nil, nil, []string{""}},
+		{"single value", stringValue("project = A"), &proto.Quals{Quals: []*proto.Qual{{FieldName: "jql", Value: stringValue("project = A")}}}, []string{"project = A"}},
+		{"list value", listValue, listQuals, []string{"project = A", "project = B"}},
+		{"first value of a split list", stringValue("project = A"), listQuals, []string{"project = A", "project = B"}},
+		{"other value of a split list", stringValue("project = B"), listQuals, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := getUserJQLs(test.qual, test.unsafeQuals)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestExtractHydrateCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     interface{}
+		expected interface{}
+	}{
+		{"count", int64(3), int64(3)},
+		{"zero", int64(0), int64(0)},
+		{"no result", struct{}{}, nil},
+		{"nil", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := extractHydrateCount(context.Background(), &transform.TransformData{HydrateItem: test.item})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIssueSearchReportsJQLErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages": ["The value 'NOPE' does not exist for the field 'project'."], "errors": {}}`)
+	})
+
+	tests := []struct {
+		name     string
+		search   issueSearch
+		userJQLs []string
+	}{
+		{"user JQL", issueSearch{MaxResults: 100}, []string{"project = NOPE"}},
+		{"qual JQL", issueSearch{MaxResults: 100, QualsJQL: `"project" = "NOPE"`}, []string{""}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.search.run(newTestContext(), client, test.userJQLs, func(*SearchIssuesResult, string) bool {
+				t.Error("expected no issues")
+				return true
+			})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), "The value 'NOPE' does not exist for the field 'project'.") {
+				t.Errorf("expected the Jira error message, got %q", err.Error())
+			}
+			if !strings.HasPrefix(err.Error(), "invalid JQL") {
+				t.Errorf("expected the JQL in the error, got %q", err.Error())
+			}
+		})
+	}
+}
+
+// decodeIssueFixture decodes an issue the same way as the search and get
+// calls of jira_issue
+func decodeIssueFixture(t *testing.T, fixture string) IssueInfo {
+	t.Helper()
+
+	var issue IssueResult
+	if err := json.Unmarshal([]byte(fixture), &issue); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+	return IssueInfo{Issue: issue.Issue, Parent: issue.Parent}
+}
+
+func TestCountSubtasks(t *testing.T) {
+	issue := decodeIssueFixture(t, `{
+		"id": "10000",
+		"key": "TEST-1",
+		"fields": {
+			"subtasks": [
+				{"id": "10001", "key": "TEST-2", "fields": {"status": {"name": "Done", "statusCategory": {"key": "done"}}}},
+				{"id": "10002", "key": "TEST-3", "fields": {"status": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}}}},
+				{"id": "10003", "key": "TEST-4", "fields": {"status": {"name": "Won't Do", "statusCategory": {"key": "done"}}}},
+				{"id": "10004", "key": "TEST-5", "fields": {"status": {"name": "To Do", "statusCategory": {"key": "new"}}}}
+			]
+		}
+	}`)
+	d := &transform.TransformData{HydrateItem: issue, Value: issue.Fields.Subtasks}
+
+	count, err := countSubtasks(context.Background(), d)
+	if err != nil || count != 4 {
+		t.Errorf("expected 4 subtasks, got %v (error %v)", count, err)
+	}
+	doneCount, err := countDoneSubtasks(context.Background(), d)
+	if err != nil || doneCount != 2 {
+		t.Errorf("expected 2 done subtasks, got %v (error %v)", doneCount, err)
+	}
+}
+
+func TestCountSubtasksWithoutSubtasks(t *testing.T) {
+	issue := decodeIssueFixture(t, `{"id": "10000", "key": "TEST-1", "fields": {}}`)
+	d := &transform.TransformData{HydrateItem: issue, Value: issue.Fields.Subtasks}
+
+	count, _ := countSubtasks(context.Background(), d)
+	doneCount, _ := countDoneSubtasks(context.Background(), d)
+	if count != 0 || doneCount != 0 {
+		t.Errorf("expected no subtasks, got %v and %v", count, doneCount)
+	}
+}
+
+func TestExtractIssueSecurityLevel(t *testing.T) {
+	restricted := decodeIssueFixture(t, `{
+		"id": "10000",
+		"key": "TEST-1",
+		"fields": {
+			"security": {"self": "https://your-domain.atlassian.net/rest/api/2/securitylevel/10100", "id": "10100", "name": "Internal", "description": "Staff only"}
+		}
+	}`)
+	unrestricted := decodeIssueFixture(t, `{"id": "10001", "key": "TEST-2", "fields": {}}`)
+
+	tests := []struct {
+		name     string
+		issue    IssueInfo
+		param    string
+		expected interface{}
+	}{
+		{"id", restricted, "id", "10100"},
+		{"name", restricted, "name", "Internal"},
+		{"unrestricted id", unrestricted, "id", nil},
+		{"unrestricted name", unrestricted, "name", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := extractIssueSecurityLevel(context.Background(), &transform.TransformData{HydrateItem: test.issue, Param: test.param})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestExtractIssueFlagged(t *testing.T) {
+	flaggedKeys := map[string]string{"flagged": "customfield_10021"}
+
+	tests := []struct {
+		name     string
+		fixture  string
+		keys     map[string]string
+		expected interface{}
+	}{
+		{
+			"flagged",
+			`{"id": "1", "key": "TEST-1", "fields": {"customfield_10021": [{"self": "https://your-domain.atlassian.net/rest/api/2/customFieldOption/10019", "value": "Impediment", "id": "10019"}]}}`,
+			flaggedKeys,
+			true,
+		},
+		{"flag cleared", `{"id": "2", "key": "TEST-2", "fields": {"customfield_10021": []}}`, flaggedKeys, false},
+		{"never flagged", `{"id": "3", "key": "TEST-3", "fields": {"customfield_10021": null}}`, flaggedKeys, false},
+		{"no Flagged field", `{"id": "4", "key": "TEST-4", "fields": {}}`, map[string]string{"flagged": ""}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			issue := decodeIssueFixture(t, test.fixture)
+			issue.Keys = test.keys
+
+			// The column reads the field with extractRequiredField first
+			value, err := extractRequiredField(context.Background(), &transform.TransformData{HydrateItem: issue, Param: "flagged"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			actual, err := extractIssueFlagged(context.Background(), &transform.TransformData{HydrateItem: issue, Value: value})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestExtractEpicKey(t *testing.T) {
+	epicKeys := map[string]string{"epic": "customfield_10014"}
+
+	tests := []struct {
+		name     string
+		fixture  string
+		expected interface{}
+	}{
+		{
+			"team-managed parent epic",
+			`{"id": "1", "key": "TEAM-2", "fields": {"parent": {"id": "10", "key": "TEAM-1", "fields": {"summary": "Checkout", "issuetype": {"id": "10001", "name": "Epic", "hierarchyLevel": 1}}}}}`,
+			"TEAM-1",
+		},
+		{
+			"renamed parent epic",
+			`{"id": "2", "key": "TEAM-3", "fields": {"parent": {"id": "10", "key": "TEAM-1", "fields": {"summary": "Checkout", "issuetype": {"id": "10001", "name": "Feature", "hierarchyLevel": 1}}}}}`,
+			"TEAM-1",
+		},
+		{
+			"subtask parent",
+			`{"id": "3", "key": "TEAM-4", "fields": {"parent": {"id": "11", "key": "TEAM-2", "fields": {"summary": "Pay", "issuetype": {"id": "10002", "name": "Story", "hierarchyLevel": 0}}}}}`,
+			nil,
+		},
+		{
+			"classic Epic Link",
+			`{"id": "4", "key": "CLS-2", "fields": {"customfield_10014": "CLS-1"}}`,
+			"CLS-1",
+		},
+		{
+			"classic subtask on Server",
+			`{"id": "5", "key": "CLS-3", "fields": {"customfield_10014": "CLS-1", "parent": {"id": "12", "key": "CLS-2", "fields": {"summary": "Pay", "issuetype": {"id": "3", "name": "Epic"}}}}}`,
+			"CLS-1",
+		},
+		{
+			"no epic",
+			`{"id": "6", "key": "CLS-4", "fields": {}}`,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			issue := decodeIssueFixture(t, test.fixture)
+			issue.Keys = epicKeys
+
+			actual, err := extractEpicKey(context.Background(), &transform.TransformData{HydrateItem: issue})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIssueUserEmails(t *testing.T) {
+	page := `{"startAt": 0, "total": 3, "issues": [
+		{"id": "1", "key": "A-1", "fields": {"assignee": {"accountId": "u1"}, "reporter": {"accountId": "u2", "emailAddress": "two@example.com"}}},
+		{"id": "2", "key": "A-2", "fields": {"assignee": {"accountId": "u3"}, "reporter": {"accountId": "u1"}}},
+		{"id": "3", "key": "A-3", "fields": {"reporter": {"accountId": "u3"}}}
+	]}`
+	var listResult SearchIssuesResult
+	if err := json.Unmarshal([]byte(page), &listResult); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		status   int
+		expected map[string][2]interface{}
+	}{
+		{
+			"bulk lookup",
+			http.StatusOK,
+			map[string][2]interface{}{
+				"A-1": {"one@example.com", "two@example.com"},
+				"A-2": {"three@example.com", "one@example.com"},
+				"A-3": {nil, "three@example.com"},
+			},
+		},
+		{
+			// Without the scope, only the addresses in the issues are returned
+			"missing scope",
+			http.StatusForbidden,
+			map[string][2]interface{}{
+				"A-1": {nil, "two@example.com"},
+				"A-2": {nil, nil},
+				"A-3": {nil, nil},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requestedAccountIds [][]string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requestedAccountIds = append(requestedAccountIds, r.URL.Query()["accountId"])
+				w.WriteHeader(test.status)
+				if test.status != http.StatusOK {
+					return
+				}
+				fmt.Fprint(w, `[{"accountId": "u1", "email": "one@example.com"}, {"accountId": "u3", "email": "three@example.com"}]`)
+			})
+			d := newTestQueryData(client, jiraConfig{})
+
+			prefetchIssueUserEmails(newTestContext(), d, listResult.Issues)
+
+			for _, issue := range listResult.Issues {
+				emails, err := getIssueUserEmails(newTestContext(), d, &plugin.HydrateData{Item: IssueInfo{Issue: issue.Issue}})
+				if err != nil {
+					t.Fatalf("unexpected error for %s: %v", issue.Key, err)
+				}
+
+				for i, role := range []string{"assignee", "reporter"} {
+					actual, err := extractIssueUserEmail(context.Background(), &transform.TransformData{HydrateItem: emails, Param: role})
+					if err != nil {
+						t.Fatalf("unexpected error for %s %s: %v", issue.Key, role, err)
+					}
+					if actual != test.expected[issue.Key][i] {
+						t.Errorf("expected %s email %v for %s, got %v", role, test.expected[issue.Key][i], issue.Key, actual)
+					}
+				}
+			}
+
+			// The missing addresses of the page are looked up in one request
+			expectedAccountIds := [][]string{{"u1", "u3"}}
+			if !reflect.DeepEqual(requestedAccountIds, expectedAccountIds) {
+				t.Errorf("expected requests for %v, got %v", expectedAccountIds, requestedAccountIds)
+			}
+		})
+	}
+}
+
+func TestGetIssueChangelogTotal(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/A-1/changelog" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		// The changelog items are never requested
+		if maxResults := r.URL.Query().Get("maxResults"); maxResults != "0" {
+			t.Errorf("expected maxResults 0, got %q", maxResults)
+		}
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 0, "total": 7, "isLast": false, "values": []}`)
+	})
+	d := newTestQueryData(client, jiraConfig{})
+
+	total, err := getIssueChangelogTotal(newTestContext(), d, &plugin.HydrateData{Item: decodeIssueFixture(t, `{"id": "1", "key": "A-1"}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != int64(7) {
+		t.Errorf("expected 7, got %v", total)
+	}
+}
+
+func TestIssueSearchCommentTotal(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if fields := r.URL.Query().Get("fields"); fields != "*navigable,comment" {
+			t.Errorf("expected the comments in the fields, got %q", fields)
+		}
+		fmt.Fprint(w, `{"startAt": 0, "total": 2, "issues": [
+			{"id": "1", "key": "A-1", "fields": {"comment": {"comments": [], "maxResults": 0, "total": 12, "startAt": 0}}},
+			{"id": "2", "key": "A-2", "fields": {"comment": {"comments": [], "maxResults": 0, "total": 0, "startAt": 0}}},
+			{"id": "3", "key": "A-3", "fields": {}}
+		]}`)
+	})
+
+	var requests int
+	totals := map[string]*int64{}
+	search := issueSearch{MaxResults: 100, Fields: []string{"*navigable", "comment"}}
+	err := search.run(newTestContext(), client, []string{""}, func(page *SearchIssuesResult, _ string) bool {
+		requests++
+		for _, issue := range page.Issues {
+			totals[issue.Key] = issue.CommentTotal
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+	expected := map[string]int64{"A-1": 12, "A-2": 0}
+	for key, total := range expected {
+		if totals[key] == nil || *totals[key] != total {
+			t.Errorf("expected comment total %d for %s, got %v", total, key, totals[key])
+		}
+	}
+	// Without the comment field, the total is unknown rather than zero
+	if totals["A-3"] != nil {
+		t.Errorf("expected no comment total for A-3, got %d", *totals["A-3"])
+	}
+}
+
+func TestIssueSearchStartAt(t *testing.T) {
+	issues := []string{`{"id": "1", "key": "A-1"}`, `{"id": "2", "key": "A-2"}`, `{"id": "3", "key": "A-3"}`, `{"id": "4", "key": "A-4"}`, `{"id": "5", "key": "A-5"}`}
+
+	var requestedStartAts []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		startAt := r.URL.Query().Get("startAt")
+		requestedStartAts = append(requestedStartAts, startAt)
+
+		var start int
+		fmt.Sscan(startAt, &start)
+		end := start + 2
+		if end > len(issues) {
+			end = len(issues)
+		}
+		fmt.Fprintf(w, `{"startAt": %d, "total": %d, "issues": [%s]}`, start, len(issues), strings.Join(issues[start:end], ","))
+	})
+
+	var streamed []string
+	search := issueSearch{StartAt: 2, MaxResults: 2}
+	err := search.run(newTestContext(), client, []string{""}, func(page *SearchIssuesResult, _ string) bool {
+		for _, issue := range page.Issues {
+			streamed = append(streamed, issue.Key)
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The issues before start_at are never requested
+	expectedStartAts := []string{"2", "4"}
+	if !reflect.DeepEqual(requestedStartAts, expectedStartAts) {
+		t.Errorf("expected pages at %v, got %v", expectedStartAts, requestedStartAts)
+	}
+	expected := []string{"A-3", "A-4", "A-5"}
+	if !reflect.DeepEqual(streamed, expected) {
+		t.Errorf("expected %v, got %v", expected, streamed)
+	}
+}
+
+func TestBuildJQLQueryFromIssueQuals(t *testing.T) {
+	type qual struct {
+		column   string
+		operator string
+		value    string
+	}
+	qualMap := func(qs ...qual) plugin.KeyColumnQualMap {
+		qualMap := plugin.KeyColumnQualMap{}
+		for _, q := range qs {
+			if qualMap[q.column] == nil {
+				qualMap[q.column] = &plugin.KeyColumnQuals{Name: q.column}
+			}
+			qualMap[q.column].Quals = append(qualMap[q.column].Quals, &quals.Qual{
+				Column:   q.column,
+				Operator: q.operator,
+				Value:    &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: q.value}},
+			})
+		}
+		return qualMap
+	}
+	columns := tableIssue(context.Background()).Columns
+
+	tests := []struct {
+		name     string
+		quals    plugin.KeyColumnQualMap
+		expected string
+	}{
+		{"status", qualMap(qual{"status", "=", "Done"}), `"status" = "Done"`},
+		{"assignee", qualMap(qual{"assignee_account_id", "=", "5b10a2844c20165700ede21g"}), `"assignee" = "5b10a2844c20165700ede21g"`},
+		{"reporter", qualMap(qual{"reporter_account_id", "=", "5b10ac8d82e05b22cc7d4ef5"}), `"reporter" = "5b10ac8d82e05b22cc7d4ef5"`},
+		{"project", qualMap(qual{"project_key", "=", "ENG"}), `"project" = "ENG"`},
+		{"issue type", qualMap(qual{"type", "=", "Bug"}), `"type" = "Bug"`},
+		{"not equal", qualMap(qual{"status", "<>", "Done"}), `status != "Done"`},
+		{
+			"combination",
+			qualMap(qual{"status", "=", "In Progress"}, qual{"assignee_account_id", "=", "5b10a2844c20165700ede21g"}, qual{"project_key", "=", "ENG"}),
+			// The clauses follow the order of the table columns
+			`"project" = "ENG" AND "status" = "In Progress" AND "assignee" = "5b10a2844c20165700ede21g"`,
+		},
+		{"raw JQL ignored", qualMap(qual{"jql", "=", "project = ENG"}), ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := buildJQLQueryFromQuals(test.quals, columns, nil)
+			if actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+
+	// The qual JQL is merged with the raw JQL
+	qualsJQL := buildJQLQueryFromQuals(qualMap(qual{"status", "=", "Done"}, qual{"project_key", "=", "ENG"}), columns, nil)
+	actual, err := combineJQL("", "labels = backend order by created", qualsJQL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `(labels = backend) AND ("project" = "ENG" AND "status" = "Done") order by created`
+	if actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestExtractVersionNames(t *testing.T) {
+	issue := decodeIssueFixture(t, `{
+		"id": "10000",
+		"key": "TEST-1",
+		"fields": {
+			"fixVersions": [
+				{"id": "10100", "name": "2.0", "released": false},
+				{"id": "10101", "name": "2.0.1", "released": false}
+			],
+			"versions": [
+				{"id": "10001", "name": "1.0", "released": true},
+				{"id": "10002", "name": "1.1", "released": true},
+				{"id": "10003", "name": "1.2", "released": true}
+			]
+		}
+	}`)
+
+	fixVersionNames, err := extractFixVersionNames(context.Background(), &transform.TransformData{Value: issue.Fields.FixVersions})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"2.0", "2.0.1"}; !reflect.DeepEqual(fixVersionNames, expected) {
+		t.Errorf("expected fix versions %v, got %v", expected, fixVersionNames)
+	}
+
+	affectedVersionNames, err := extractAffectedVersionNames(context.Background(), &transform.TransformData{Value: issue.Fields.AffectsVersions})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"1.0", "1.1", "1.2"}; !reflect.DeepEqual(affectedVersionNames, expected) {
+		t.Errorf("expected affected versions %v, got %v", expected, affectedVersionNames)
+	}
+
+	// Issues without versions have no names
+	issue = decodeIssueFixture(t, `{"id": "10001", "key": "TEST-2", "fields": {}}`)
+	fixVersionNames, _ = extractFixVersionNames(context.Background(), &transform.TransformData{Value: issue.Fields.FixVersions})
+	affectedVersionNames, _ = extractAffectedVersionNames(context.Background(), &transform.TransformData{Value: issue.Fields.AffectsVersions})
+	if fixVersionNames.([]string) != nil || affectedVersionNames.([]string) != nil {
+		t.Errorf("expected no versions, got %v and %v", fixVersionNames, affectedVersionNames)
+	}
+}
+
+func TestIssueVotesAndWatchesToggles(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/TEST-1" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `{
+			"id": "10000",
+			"key": "TEST-1",
+			"names": {},
+			"fields": {
+				"votes": {"votes": 3, "hasVoted": true},
+				"watches": {"watchCount": 5, "isWatching": false}
+			}
+		}`)
+	})
+	enabled, disabled := false, true
+
+	tests := []struct {
+		name           string
+		disableVotes   *bool
+		disableWatches *bool
+		expectVotes    bool
+		expectWatches  bool
+	}{
+		{"defaults", nil, nil, true, true},
+		{"both enabled", &enabled, &enabled, true, true},
+		{"votes disabled", &disabled, nil, false, true},
+		{"watches disabled", nil, &disabled, true, false},
+		{"both disabled", &disabled, &disabled, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := newTestQueryData(client, jiraConfig{DisableVotes: test.disableVotes, DisableWatches: test.disableWatches})
+			d.KeyColumnQuals = map[string]*proto.QualValue{
+				"key": {Value: &proto.QualValue_StringValue{StringValue: "TEST-1"}},
+			}
+
+			issue, err := getIssue(newTestContext(), d, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			votes, _ := extractIssueVotes(context.Background(), &transform.TransformData{HydrateItem: issue})
+			voteCount, _ := extractVoteCount(context.Background(), &transform.TransformData{Value: votes})
+			hasVoted, _ := extractHasVoted(context.Background(), &transform.TransformData{Value: votes})
+			if test.expectVotes {
+				if voteCount != float64(3) || hasVoted != true {
+					t.Errorf("expected 3 votes with has voted, got %v and %v", voteCount, hasVoted)
+				}
+			} else if voteCount != nil || hasVoted != nil {
+				t.Errorf("expected no votes, got %v and %v", voteCount, hasVoted)
+			}
+
+			watches, _ := extractIssueWatches(context.Background(), &transform.TransformData{HydrateItem: issue})
+			watchCount, _ := extractWatchCount(context.Background(), &transform.TransformData{Value: watches})
+			if test.expectWatches {
+				if watchCount != 5 {
+					t.Errorf("expected 5 watchers, got %v", watchCount)
+				}
+			} else if watchCount != nil {
+				t.Errorf("expected no watchers, got %v", watchCount)
+			}
+		})
+	}
+}
+
+func TestExtractIssueRawRoundTrip(t *testing.T) {
+	issueJSON := `{
+		"id": "10000",
+		"key": "TEST-1",
+		"self": "https://example.atlassian.net/rest/api/2/issue/10000",
+		"fields": {
+			"summary": "Fix the login page",
+			"status": {"name": "In Progress", "statusCategory": {"id": 4, "key": "indeterminate"}},
+			"labels": ["frontend", "auth"],
+			"customfield_10050": {"value": "Platform", "id": "10100"},
+			"customfield_10051": [1.5, null, "mixed"],
+			"customfield_10052": null,
+			"timeoriginalestimate": 7200
+		}
+	}`
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"startAt": 0, "total": 1, "issues": [%s]}`, issueJSON)
+	})
+
+	var raws []interface{}
+	search := issueSearch{MaxResults: 100}
+	err := search.run(newTestContext(), client, []string{""}, func(page *SearchIssuesResult, _ string) bool {
+		for _, issue := range page.Issues {
+			raw, err := extractIssueRaw(context.Background(), &transform.TransformData{HydrateItem: IssueInfo{Issue: issue.Issue, Raw: issue.Raw}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			raws = append(raws, raw)
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Every field of the payload is kept, including the ones without a column
+	var expected interface{}
+	if err := json.Unmarshal([]byte(issueJSON), &expected); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+	if len(raws) != 1 || !reflect.DeepEqual(raws[0], expected) {
+		t.Errorf("expected %v, got %v", expected, raws)
+	}
+
+	// Without the payload, e.g. when raw isn't selected, the column is null
+	raw, err := extractIssueRaw(context.Background(), &transform.TransformData{HydrateItem: IssueInfo{}})
+	if err != nil || raw != nil {
+		t.Errorf("expected no raw payload, got %v (error %v)", raw, err)
+	}
+}
+
+func TestIssueStatusCategoryKey(t *testing.T) {
+	columns := tableIssue(context.Background()).Columns
+
+	var column *plugin.Column
+	for _, c := range columns {
+		if c.Name == "status_category_key" {
+			column = c
+		}
+	}
+	if column == nil {
+		t.Fatal("column status_category_key not found")
+	}
+
+	transformTests := []struct {
+		fixture  string
+		expected interface{}
+	}{
+		{`{"id": "1", "key": "TEST-1", "fields": {"status": {"name": "To Do", "statusCategory": {"id": 2, "key": "new", "name": "To Do"}}}}`, "new"},
+		{`{"id": "2", "key": "TEST-2", "fields": {"status": {"name": "In Review", "statusCategory": {"id": 4, "key": "indeterminate", "name": "In Progress"}}}}`, "indeterminate"},
+		{`{"id": "3", "key": "TEST-3", "fields": {"status": {"name": "Closed", "statusCategory": {"id": 3, "key": "done", "name": "Done"}}}}`, "done"},
+	}
+	for _, test := range transformTests {
+		issue := decodeIssueFixture(t, test.fixture)
+		actual, err := column.Transform.Execute(context.Background(), &transform.TransformData{HydrateItem: issue, ColumnName: column.Name}, transform.FromCamel())
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", issue.Key, err)
+		}
+		if actual != test.expected {
+			t.Errorf("expected %v for %s, got %v", test.expected, issue.Key, actual)
+		}
+	}
+
+	// JQL doesn't accept the category keys, so they are translated to the IDs
+	jqlTests := []struct {
+		operator string
+		value    string
+		expected string
+	}{
+		{"=", "new", `"statusCategory" = "2"`},
+		{"=", "indeterminate", `"statusCategory" = "4"`},
+		{"=", "done", `"statusCategory" = "3"`},
+		{"<>", "done", `statusCategory != "3"`},
+		{"=", "To Do", `"statusCategory" = "To Do"`},
+	}
+	for _, test := range jqlTests {
+		qualMap := plugin.KeyColumnQualMap{
+			"status_category_key": &plugin.KeyColumnQuals{
+				Name: "status_category_key",
+				Quals: quals.QualSlice{{
+					Column:   "status_category_key",
+					Operator: test.operator,
+					Value:    &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: test.value}},
+				}},
+			},
+		}
+		actual := buildJQLQueryFromQuals(qualMap, columns, nil)
+		if actual != test.expected {
+			t.Errorf("expected %q for %s %s, got %q", test.expected, test.operator, test.value, actual)
+		}
+	}
+}
+
+func TestIssueSearchValidateQuery(t *testing.T) {
+	tests := []struct {
+		validateQuery string
+		expected      []string
+	}{
+		// The API defaults to strict validation
+		{"", nil},
+		{"strict", []string{"strict"}},
+		{"warn", []string{"warn"}},
+		{"none", []string{"none"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.validateQuery, func(t *testing.T) {
+			var requested []string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requested = r.URL.Query()["validateQuery"]
+				fmt.Fprint(w, `{"startAt": 0, "total": 0, "issues": []}`)
+			})
+
+			search := issueSearch{MaxResults: 100, ValidateQuery: test.validateQuery}
+			err := search.run(newTestContext(), client, []string{"project = ENG"}, func(*SearchIssuesResult, string) bool {
+				return true
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(requested, test.expected) {
+				t.Errorf("expected validateQuery %v, got %v", test.expected, requested)
+			}
+		})
+	}
+}
+
+func TestListIssuesInvalidValidateQuery(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	})
+	d := newTestQueryData(client, jiraConfig{})
+	d.KeyColumnQuals = map[string]*proto.QualValue{
+		"validate_query": {Value: &proto.QualValue_StringValue{StringValue: "loose"}},
+	}
+
+	_, err := listIssues(newTestContext(), d, nil)
+	if err == nil || !strings.Contains(err.Error(), `invalid validate_query "loose"`) {
+		t.Errorf("expected an invalid validate_query error, got %v", err)
+	}
+}
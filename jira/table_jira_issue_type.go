@@ -3,7 +3,6 @@ package jira
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
@@ -63,8 +62,9 @@ func tableIssueType(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "hierarchy_level",
-				Description: "Hierarchy level of the issue type.",
+				Description: "Hierarchy level of the issue type. Instances that don't report levels default to -1 for subtasks and 0 for other issue types.",
 				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(issueTypeHierarchyLevel),
 			},
 			{
 				Name:        "icon_url",
@@ -72,6 +72,18 @@ func tableIssueType(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromGo(),
 			},
+			{
+				Name:        "scope_type",
+				Description: "The type of the scope of the issue type. PROJECT for issue types of team-managed projects, null for global issue types.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Scope.Type").NullIfZero(),
+			},
+			{
+				Name:        "scope_project_id",
+				Description: "The ID of the team-managed project the issue type is available in. Null for global issue types.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Scope.Project.ID").NullIfZero(),
+			},
 			{
 				Name:        "subtask",
 				Description: "Whether this issue type is used to create subtasks.",
@@ -99,30 +111,12 @@ func tableIssueType(_ context.Context) *plugin.Table {
 //// LIST FUNCTION
 
 func listIssueTypes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	issuesTypeResult, err := getIssueTypes(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("jira_issue_type.listIssueTypes", "connection_error", err)
 		return nil, err
 	}
 
-	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-types/
-	// Paging not supported
-	req, err := client.NewRequest("GET", "/rest/api/3/issuetype", nil)
-	if err != nil {
-		if isNotFoundError(err) || strings.Contains(err.Error(), "400") {
-			return nil, nil
-		}
-		plugin.Logger(ctx).Error("jira_issue_type.listIssueTypes", "api_error", err)
-		return nil, err
-	}
-
-	issuesTypeResult := new([]ListIssuesTypeResult)
-	_, err = client.Do(req, issuesTypeResult)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, issueType := range *issuesTypeResult {
+	for _, issueType := range issuesTypeResult {
 		d.StreamListItem(ctx, issueType)
 		// Context may get cancelled due to manual cancellation or if the limit has been reached
 		if d.QueryStatus.RowsRemaining(ctx) == 0 {
@@ -130,7 +124,7 @@ func listIssueTypes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		}
 	}
 
-	return nil, err
+	return nil, nil
 }
 
 //// HYDRATE FUNCTION
@@ -149,13 +143,13 @@ func getIssueType(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	}
 
 	apiEndpoint := fmt.Sprintf("/rest/api/3/issuetype/%s", issueTypeID)
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_issue_type.getIssueType", "get_request_error", err)
 		return nil, err
 	}
 
-	_, err = client.Do(req, issueType)
+	_, err = doRequest(ctx, client, req, issueType)
 	if err != nil && isNotFoundError(err) {
 		plugin.Logger(ctx).Error("jira_issue_type.getIssueType", "api_error", err)
 		return nil, nil
@@ -164,6 +158,70 @@ func getIssueType(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	return issueType, err
 }
 
+//// TRANSFORM FUNCTION
+
+func issueTypeHierarchyLevel(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch issueType := d.HydrateItem.(type) {
+	case ListIssuesTypeResult:
+		return getIssueTypeHierarchyLevel(issueType), nil
+	case *ListIssuesTypeResult:
+		return getIssueTypeHierarchyLevel(*issueType), nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// getIssueTypes returns all the issue types, reusing them within the
+// configured cache TTL as they rarely change
+func getIssueTypes(ctx context.Context, d *plugin.QueryData) ([]ListIssuesTypeResult, error) {
+	issueTypes, err := getWithCacheTTL(d, "jira_issue_type.listIssueTypes", func() (interface{}, error) {
+		client, err := connect(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type.getIssueTypes", "connection_error", err)
+			return nil, err
+		}
+
+		// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-types/
+		// Paging not supported
+		req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/3/issuetype", nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type.getIssueTypes", "get_request_error", err)
+			return nil, err
+		}
+
+		var issuesTypeResult []ListIssuesTypeResult
+		_, err = doRequest(ctx, client, req, &issuesTypeResult)
+		if err != nil {
+			if isNotFoundError(err) || isBadRequestError(err) {
+				return []ListIssuesTypeResult(nil), nil
+			}
+			plugin.Logger(ctx).Error("jira_issue_type.getIssueTypes", "api_error", err)
+			return nil, err
+		}
+
+		return issuesTypeResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issueTypes.([]ListIssuesTypeResult), nil
+}
+
+// getIssueTypeHierarchyLevel returns the hierarchy level of the issue type.
+// Server instances don't report levels, so default to -1 for subtasks and 0
+// for the other issue types.
+func getIssueTypeHierarchyLevel(issueType ListIssuesTypeResult) int32 {
+	if issueType.HierarchyLevel != nil {
+		return *issueType.HierarchyLevel
+	}
+	if issueType.Subtask {
+		return -1
+	}
+	return 0
+}
+
 //// Required Structs
 
 type ListIssuesTypeResult struct {
@@ -175,7 +233,7 @@ type ListIssuesTypeResult struct {
 	Subtask        bool           `json:"subtask"`
 	AvatarID       int64          `json:"avatarId"`
 	EntityID       int64          `json:"entityId"`
-	HierarchyLevel int32          `json:"hierarchyLevel"`
+	HierarchyLevel *int32         `json:"hierarchyLevel"`
 	Scope          IssueTypeScope `json:"scope"`
 }
 
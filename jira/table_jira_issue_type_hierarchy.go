@@ -0,0 +1,121 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueTypeHierarchy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_type_hierarchy",
+		Description: "The levels of the issue type hierarchy, e.g. Epic > Story > Subtask, with the issue types at each level.",
+		List: &plugin.ListConfig{
+			Hydrate: listIssueTypeHierarchy,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "level",
+				Description: "The hierarchy level. Subtasks are at level -1, standard issue types at level 0 and epics at level 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the hierarchy level, e.g. Subtask, Standard or Epic.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "issue_type_ids",
+				Description: "The IDs of the issue types at this hierarchy level.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "issue_type_names",
+				Description: "The names of the issue types at this hierarchy level.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueTypeHierarchy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	issueTypes, err := getIssueTypes(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_hierarchy.listIssueTypeHierarchy", "api_error", err)
+		return nil, err
+	}
+
+	levels := map[int32]*IssueTypeHierarchyLevel{}
+	for _, issueType := range issueTypes {
+		level := getIssueTypeHierarchyLevel(issueType)
+		if levels[level] == nil {
+			levels[level] = &IssueTypeHierarchyLevel{
+				Level:          level,
+				Name:           issueTypeHierarchyLevelName(level),
+				IssueTypeIds:   []string{},
+				IssueTypeNames: []string{},
+			}
+		}
+		levels[level].IssueTypeIds = append(levels[level].IssueTypeIds, issueType.ID)
+		levels[level].IssueTypeNames = append(levels[level].IssueTypeNames, issueType.Name)
+	}
+
+	// Stream the levels from the top of the hierarchy down
+	var sortedLevels []int32
+	for level := range levels {
+		sortedLevels = append(sortedLevels, level)
+	}
+	sort.Slice(sortedLevels, func(i, j int) bool { return sortedLevels[i] > sortedLevels[j] })
+
+	for _, level := range sortedLevels {
+		d.StreamListItem(ctx, levels[level])
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func issueTypeHierarchyLevelName(level int32) string {
+	switch level {
+	case -1:
+		return "Subtask"
+	case 0:
+		return "Standard"
+	case 1:
+		return "Epic"
+	}
+	return fmt.Sprintf("Level %d", level)
+}
+
+//// Custom Structs
+
+type IssueTypeHierarchyLevel struct {
+	Level          int32
+	Name           string
+	IssueTypeIds   []string
+	IssueTypeNames []string
+}
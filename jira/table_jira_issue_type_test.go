@@ -0,0 +1,60 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func TestIssueTypeScopeColumns(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"id": "10001", "name": "Bug", "iconUrl": "https://example.atlassian.net/images/bug.svg", "avatarId": 10303, "subtask": false, "hierarchyLevel": 0},
+			{"id": "10042", "name": "Task", "iconUrl": "https://example.atlassian.net/images/task.svg", "avatarId": 10318, "subtask": false, "hierarchyLevel": 0,
+				"scope": {"type": "PROJECT", "project": {"id": "10005"}}}
+		]`)
+	})
+	d := newTestQueryData(client, jiraConfig{})
+
+	issueTypes, err := getIssueTypes(newTestContext(), d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issueTypes) != 2 {
+		t.Fatalf("expected 2 issue types, got %d", len(issueTypes))
+	}
+
+	expected := map[string]map[string]interface{}{
+		"Bug":  {"scope_type": nil, "scope_project_id": nil, "icon_url": "https://example.atlassian.net/images/bug.svg", "avatar_id": int64(10303)},
+		"Task": {"scope_type": "PROJECT", "scope_project_id": "10005", "icon_url": "https://example.atlassian.net/images/task.svg", "avatar_id": int64(10318)},
+	}
+
+	var checked int
+	for _, column := range tableIssueType(context.Background()).Columns {
+		for _, issueType := range issueTypes {
+			expectedValue, ok := expected[issueType.Name][column.Name]
+			if !ok {
+				continue
+			}
+			checked++
+
+			columnTransform := column.Transform
+			if columnTransform == nil {
+				columnTransform = transform.FromCamel()
+			}
+			actual, err := columnTransform.Execute(context.Background(), &transform.TransformData{HydrateItem: issueType, ColumnName: column.Name}, transform.FromCamel())
+			if err != nil {
+				t.Fatalf("unexpected error for %s: %v", column.Name, err)
+			}
+			if actual != expectedValue {
+				t.Errorf("expected %s %v for %s, got %v", column.Name, expectedValue, issueType.Name, actual)
+			}
+		}
+	}
+	if checked != 8 {
+		t.Errorf("expected 8 values to be checked, got %d", checked)
+	}
+}
@@ -0,0 +1,122 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableLicense(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_license",
+		Description: "License details of a Jira Data Center or Server instance.",
+		List: &plugin.ListConfig{
+			Hydrate: listLicenses,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "organization",
+				Description: "The organization the license is issued to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_type",
+				Description: "The type of the license, e.g. COMMERCIAL or ACADEMIC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enterprise",
+				Description: "Whether the license is an enterprise license.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "data_center",
+				Description: "Whether the license is a Data Center license.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "maximum_users",
+				Description: "The maximum number of users allowed by the license.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "active_users",
+				Description: "The number of users counting towards the license.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// json fields
+			{
+				Name:        "products",
+				Description: "The licensed products.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listLicenses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	isCloud, err := isCloudDeployment(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_license.listLicenses", "server_info_error", err)
+		return nil, err
+	}
+
+	// The license endpoint is only available on Data Center and Server
+	if isCloud {
+		plugin.Logger(ctx).Info("jira_license.listLicenses", "The license endpoint is not available on Jira Cloud, no rows returned")
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_license.listLicenses", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/2/instance/license", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_license.listLicenses", "get_request_error", err)
+		return nil, err
+	}
+
+	license := new(License)
+	_, err = doRequest(ctx, client, req, license)
+	if err != nil {
+		// Only administrators can read the license details
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_license.listLicenses", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, license)
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type License struct {
+	Organization string           `json:"organization"`
+	LicenseType  string           `json:"licenseType"`
+	Enterprise   bool             `json:"enterprise"`
+	DataCenter   bool             `json:"dataCenter"`
+	MaximumUsers int64            `json:"maximumUsers"`
+	ActiveUsers  int64            `json:"activeUsers"`
+	Products     []LicenseProduct `json:"products"`
+}
+
+type LicenseProduct struct {
+	Key          string `json:"key"`
+	Name         string `json:"name"`
+	MaximumUsers int64  `json:"maximumUsers"`
+	ActiveUsers  int64  `json:"activeUsers"`
+}
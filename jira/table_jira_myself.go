@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableMyself(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_myself",
+		Description: "The user the connection is authenticated as.",
+		List: &plugin.ListConfig{
+			Hydrate: listMyself,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "account_id",
+				Description: "The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the user. Depending on the user's privacy setting, this may return an alternative value.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_address",
+				Description: "The email address of the user. Depending on the user's privacy setting, this may be returned as null.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_type",
+				Description: "The user account type. Can take the following values: atlassian, app, customer and unknown.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active",
+				Description: "Indicates if user is active.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "time_zone",
+				Description: "The time zone specified in the user's profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "locale",
+				Description: "The locale of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "groups",
+				Description: "The groups that the user belongs to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Groups.Items"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listMyself(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_myself.listMyself", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "rest/api/2/myself?expand=groups", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_myself.listMyself", "get_request_error", err)
+		return nil, err
+	}
+
+	myself := new(Myself)
+	_, err = doRequest(ctx, client, req, myself)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_myself.listMyself", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, myself)
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type Myself struct {
+	Self         string       `json:"self"`
+	AccountID    string       `json:"accountId"`
+	AccountType  string       `json:"accountType"`
+	EmailAddress string       `json:"emailAddress"`
+	DisplayName  string       `json:"displayName"`
+	Active       bool         `json:"active"`
+	TimeZone     string       `json:"timeZone"`
+	Locale       string       `json:"locale"`
+	Groups       MyselfGroups `json:"groups"`
+}
+
+type MyselfGroups struct {
+	Size  int           `json:"size"`
+	Items []MyselfGroup `json:"items"`
+}
+
+type MyselfGroup struct {
+	Name    string `json:"name"`
+	GroupId string `json:"groupId"`
+	Self    string `json:"self"`
+}
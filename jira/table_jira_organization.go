@@ -0,0 +1,156 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableOrganization(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_organization",
+		Description: "Customer organizations of Jira Service Management.",
+		List: &plugin.ListConfig{
+			Hydrate: listOrganizations,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the organization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the organization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the organization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Links.Self"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOrganizations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_organization.listOrganizations", "connection_error", err)
+		return nil, err
+	}
+
+	err = forEachOrganization(ctx, d, client, func(organization Organization) (bool, error) {
+		d.StreamListItem(ctx, organization)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.QueryStatus.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_organization.listOrganizations", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// forEachOrganization:: calls fn with each organization, until it returns
+// false or an error. Child tables of organizations use it rather than
+// listOrganizations as parent hydrate, so that they can skip the listing
+// when the organization is given.
+func forEachOrganization(ctx context.Context, d *plugin.QueryData, client *jira.Client, fn func(Organization) (bool, error)) error {
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := getPageSize(d, 100)
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/servicedeskapi/organization?start=%d&limit=%d", last, limit)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		listResult := new(ListOrganizationResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				plugin.Logger(ctx).Info("jira_organization.forEachOrganization", "Jira Service Management is not installed, no rows returned")
+				return nil
+			}
+			return err
+		}
+
+		for _, organization := range listResult.Values {
+			more, err := fn(organization)
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		last = listResult.Start + len(listResult.Values)
+		if listResult.IsLastPage || len(listResult.Values) == 0 {
+			return nil
+		}
+	}
+}
+
+// getOrganization:: returns the organization with the given ID, or nil if
+// there is no such organization
+func getOrganization(ctx context.Context, client *jira.Client, organizationId string) (*Organization, error) {
+	apiEndpoint := fmt.Sprintf("/rest/servicedeskapi/organization/%s", url.PathEscape(organizationId))
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	organization := new(Organization)
+	_, err = doRequest(ctx, client, req, organization)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+//// Custom Structs
+
+type ListOrganizationResult struct {
+	Size       int            `json:"size"`
+	Start      int            `json:"start"`
+	Limit      int            `json:"limit"`
+	IsLastPage bool           `json:"isLastPage"`
+	Values     []Organization `json:"values"`
+}
+
+type Organization struct {
+	Id    string           `json:"id"`
+	Name  string           `json:"name"`
+	Links ServiceDeskLinks `json:"_links"`
+}
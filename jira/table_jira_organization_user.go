@@ -0,0 +1,184 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableOrganizationUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_organization_user",
+		Description: "Customers that belong to a Jira Service Management organization.",
+		List: &plugin.ListConfig{
+			Hydrate: listOrganizationUsers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization_name",
+				Description: "The name of the organization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: "The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.AccountId"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the user. Depending on the user's privacy setting, this may return an alternative value.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.DisplayName"),
+			},
+			{
+				Name:        "email_address",
+				Description: "The email address of the user. Depending on the user's privacy setting, this may be returned as null.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.EmailAddress").NullIfZero(),
+			},
+			{
+				Name:        "active",
+				Description: "Indicates if user is active.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("User.Active"),
+			},
+			{
+				Name:        "time_zone",
+				Description: "The time zone specified in the user's profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.TimeZone").NullIfZero(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.DisplayName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOrganizationUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_organization_user.listOrganizationUsers", "connection_error", err)
+		return nil, err
+	}
+
+	// Only fetch the users of the requested organization, if one is given. Its
+	// name is only looked up if it's selected.
+	organizationId := d.KeyColumnQualString("organization_id")
+	if organizationId != "" {
+		organization := &Organization{Id: organizationId}
+		if isColumnRequested(d, "organization_name") {
+			organization, err = getOrganization(ctx, client, organizationId)
+			if err != nil {
+				plugin.Logger(ctx).Error("jira_organization_user.listOrganizationUsers", "api_error", err)
+				return nil, err
+			}
+			if organization == nil {
+				return nil, nil
+			}
+		}
+		_, err = listUsersForOrganization(ctx, d, client, *organization)
+		return nil, err
+	}
+
+	err = forEachOrganization(ctx, d, client, func(organization Organization) (bool, error) {
+		done, err := listUsersForOrganization(ctx, d, client, organization)
+		return !done, err
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_organization_user.listOrganizationUsers", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// listUsersForOrganization streams the users of a single organization and
+// reports whether the query limit has been reached
+func listUsersForOrganization(ctx context.Context, d *plugin.QueryData, client *jira.Client, organization Organization) (bool, error) {
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := getPageSize(d, 100)
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/servicedeskapi/organization/%s/user?start=%d&limit=%d", url.PathEscape(organization.Id), last, limit)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_organization_user.listUsersForOrganization", "get_request_error", err)
+			return false, err
+		}
+
+		listResult := new(ListOrganizationUserResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				return false, nil
+			}
+			plugin.Logger(ctx).Error("jira_organization_user.listUsersForOrganization", "api_error", err)
+			return false, err
+		}
+
+		for _, user := range listResult.Values {
+			d.StreamListItem(ctx, OrganizationUserInfo{organization.Id, organization.Name, user})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return true, nil
+			}
+		}
+
+		last = listResult.Start + len(listResult.Values)
+		if listResult.IsLastPage || len(listResult.Values) == 0 {
+			return false, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListOrganizationUserResult struct {
+	Size       int                `json:"size"`
+	Start      int                `json:"start"`
+	Limit      int                `json:"limit"`
+	IsLastPage bool               `json:"isLastPage"`
+	Values     []OrganizationUser `json:"values"`
+}
+
+type OrganizationUser struct {
+	AccountId    string `json:"accountId"`
+	EmailAddress string `json:"emailAddress"`
+	DisplayName  string `json:"displayName"`
+	Active       bool   `json:"active"`
+	TimeZone     string `json:"timeZone"`
+}
+
+type OrganizationUserInfo struct {
+	OrganizationId   string
+	OrganizationName string
+	User             OrganizationUser
+}
@@ -0,0 +1,166 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tablePermissionGrant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_permission_grant",
+		Description: "The permission grants of permission schemes, with a row for each grant.",
+		List: &plugin.ListConfig{
+			Hydrate: listPermissionGrants,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "permission_scheme_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "permission_scheme_id",
+				Description: "The ID of the permission scheme.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "permission_scheme_name",
+				Description: "The name of the permission scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the permission grant.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Grant.Id"),
+			},
+			{
+				Name:        "permission",
+				Description: "The permission granted, e.g. ADMINISTER_PROJECTS or BROWSE_PROJECTS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.Permission"),
+			},
+			{
+				Name:        "holder_type",
+				Description: "The type of the holder of the permission, e.g. group, user, projectRole or applicationRole.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.Holder.Type"),
+			},
+			{
+				Name:        "holder_parameter",
+				Description: "The identifier of the holder, e.g. the group name, account ID or project role ID. Null for holders like anyone or reporter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.Holder.Parameter").NullIfZero(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.Permission"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listPermissionGrants(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_permission_grant.listPermissionGrants", "connection_error", err)
+		return nil, err
+	}
+
+	var schemes []PermissionScheme
+	if d.KeyColumnQuals["permission_scheme_id"] != nil {
+		schemeId := d.KeyColumnQuals["permission_scheme_id"].GetInt64Value()
+		apiEndpoint := fmt.Sprintf("/rest/api/2/permissionscheme/%d?expand=permissions", schemeId)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_permission_grant.listPermissionGrants", "get_request_error", err)
+			return nil, err
+		}
+
+		scheme := new(PermissionScheme)
+		_, err = doRequest(ctx, client, req, scheme)
+		if err != nil {
+			if isNotFoundError(err) || isForbiddenError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_permission_grant.listPermissionGrants", "api_error", err)
+			return nil, err
+		}
+		schemes = append(schemes, *scheme)
+	} else {
+		// Paging not supported
+		req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/2/permissionscheme?expand=permissions", nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_permission_grant.listPermissionGrants", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListPermissionSchemeResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			// Only administrators can list the permission schemes
+			if isForbiddenError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_permission_grant.listPermissionGrants", "api_error", err)
+			return nil, err
+		}
+		schemes = listResult.PermissionSchemes
+	}
+
+	for _, scheme := range schemes {
+		for _, grant := range scheme.Permissions {
+			d.StreamListItem(ctx, PermissionGrantInfo{scheme.Id, scheme.Name, grant})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ListPermissionSchemeResult struct {
+	PermissionSchemes []PermissionScheme `json:"permissionSchemes"`
+}
+
+type PermissionScheme struct {
+	Id          int64             `json:"id"`
+	Self        string            `json:"self"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Permissions []PermissionGrant `json:"permissions"`
+}
+
+type PermissionGrant struct {
+	Id         int64                 `json:"id"`
+	Self       string                `json:"self"`
+	Holder     PermissionGrantHolder `json:"holder"`
+	Permission string                `json:"permission"`
+}
+
+type PermissionGrantHolder struct {
+	Type      string `json:"type"`
+	Parameter string `json:"parameter"`
+}
+
+type PermissionGrantInfo struct {
+	PermissionSchemeId   int64
+	PermissionSchemeName string
+	Grant                PermissionGrant
+}
@@ -72,26 +72,12 @@ func tablePriority(_ context.Context) *plugin.Table {
 //// LIST FUNCTION
 
 func listPriorities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
-	if err != nil {
-		plugin.Logger(ctx).Error("jira_priority.listPriorities", "connection_error", err)
-		return nil, err
-	}
-
-	req, err := client.NewRequest("GET", "rest/api/3/priority", nil)
+	priorities, err := getPriorities(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("jira_priority.listPriorities", "get_request_error", err)
 		return nil, err
 	}
-	priorities := new([]jira.Priority)
 
-	_, err = client.Do(req, priorities)
-	if err != nil {
-		plugin.Logger(ctx).Error("jira_priority.listPriorities", "api_error", err)
-		return nil, err
-	}
-
-	for _, priority := range *priorities {
+	for _, priority := range priorities {
 		d.StreamListItem(ctx, priority)
 		// Context may get cancelled due to manual cancellation or if the limit has been reached
 		if d.QueryStatus.RowsRemaining(ctx) == 0 {
@@ -99,7 +85,7 @@ func listPriorities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		}
 	}
 
-	return nil, err
+	return nil, nil
 }
 
 //// HYDRATE FUNCTIONS
@@ -118,14 +104,14 @@ func getPriority(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	}
 
 	apiEndpoint := fmt.Sprintf("/rest/api/3/priority/%s", priorityId)
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_priority.getPriority", "get_request_error", err)
 		return nil, err
 	}
 	result := new(jira.Priority)
 
-	_, err = client.Do(req, result)
+	_, err = doRequest(ctx, client, req, result)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_priority.getPriority", "api_error", err)
 		return nil, err
@@ -133,3 +119,37 @@ func getPriority(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 
 	return result, nil
 }
+
+//// UTILITY FUNCTIONS
+
+// getPriorities returns all the priorities, reusing them within the
+// configured cache TTL as they rarely change
+func getPriorities(ctx context.Context, d *plugin.QueryData) ([]jira.Priority, error) {
+	priorities, err := getWithCacheTTL(d, "jira_priority.listPriorities", func() (interface{}, error) {
+		client, err := connect(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_priority.getPriorities", "connection_error", err)
+			return nil, err
+		}
+
+		req, err := client.NewRequestWithContext(ctx, "GET", "rest/api/3/priority", nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_priority.getPriorities", "get_request_error", err)
+			return nil, err
+		}
+
+		var priorities []jira.Priority
+		_, err = doRequest(ctx, client, req, &priorities)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_priority.getPriorities", "api_error", err)
+			return nil, err
+		}
+
+		return priorities, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return priorities.([]jira.Priority), nil
+}
@@ -0,0 +1,44 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetPrioritiesCache(t *testing.T) {
+	ttl := 60
+
+	tests := []struct {
+		name          string
+		config        jiraConfig
+		expectedCalls int
+	}{
+		{"cache hit within the TTL", jiraConfig{CacheTTLSeconds: &ttl}, 1},
+		{"cache disabled", jiraConfig{}, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				fmt.Fprint(w, `[{"id": "1", "name": "Highest"}, {"id": "2", "name": "High"}]`)
+			})
+			d := newTestQueryData(client, test.config)
+
+			for i := 0; i < 2; i++ {
+				priorities, err := getPriorities(newTestContext(), d)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(priorities) != 2 || priorities[0].Name != "Highest" {
+					t.Errorf("expected the priorities of the fixture, got %v", priorities)
+				}
+			}
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
@@ -26,6 +27,26 @@ func tableProject(_ context.Context) *plugin.Table {
 			KeyColumns: plugin.KeyColumnSlice{
 				{Name: "key", Require: plugin.Optional},
 				{Name: "project_type_key", Require: plugin.Optional},
+				{Name: "recent", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				// Limit concurrency to avoid a 429 too many requests error
+				Func:           getProject,
+				MaxConcurrency: 10,
+			},
+			{
+				Func:           getProjectPermissionScheme,
+				MaxConcurrency: 10,
+			},
+			{
+				Func:           getProjectNotificationScheme,
+				MaxConcurrency: 10,
+			},
+			{
+				Func:           getProjectIssueTypeScheme,
+				MaxConcurrency: 10,
 			},
 		},
 		Columns: []*plugin.Column{
@@ -78,6 +99,49 @@ func tableProject(_ context.Context) *plugin.Table {
 				Hydrate:     getProject,
 				Transform:   transform.FromField("Lead.DisplayName"),
 			},
+			{
+				Name:        "lead_active",
+				Description: "Whether the account of the project lead is active.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getProject,
+				Transform:   transform.FromField("Lead.Active"),
+			},
+			{
+				Name:        "archived",
+				Description: "Whether the project is archived. Null on Jira Server, where archival is not reported.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "archived_date",
+				Description: "The time when the project was archived.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ArchivedDate").NullIfZero(),
+			},
+			{
+				Name:        "deleted",
+				Description: "Whether the project is in the recycle bin. Null on Jira Server, where deletion is not reported.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "deleted_date",
+				Description: "The time when the project was moved to the recycle bin.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DeletedDate").NullIfZero(),
+			},
+			{
+				Name:        "component_count",
+				Description: "The number of components in the project.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getProject,
+				Transform:   transform.FromField("Components").Transform(countProjectItems),
+			},
+			{
+				Name:        "version_count",
+				Description: "The number of versions in the project.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getProject,
+				Transform:   transform.FromField("Versions").Transform(countProjectItems),
+			},
 			{
 				Name:        "project_type_key",
 				Description: "The project type of the project. Valid values are software, service_desk and business.",
@@ -90,6 +154,55 @@ func tableProject(_ context.Context) *plugin.Table {
 				Hydrate:     getProject,
 				Transform:   transform.FromField("url"),
 			},
+			{
+				Name:        "permission_scheme_id",
+				Description: "The ID of the permission scheme assigned to the project.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getProjectPermissionScheme,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "permission_scheme_name",
+				Description: "The name of the permission scheme assigned to the project.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProjectPermissionScheme,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "notification_scheme_id",
+				Description: "The ID of the notification scheme assigned to the project.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getProjectNotificationScheme,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "notification_scheme_name",
+				Description: "The name of the notification scheme assigned to the project.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProjectNotificationScheme,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "issue_type_scheme_id",
+				Description: "The ID of the issue type scheme assigned to the project.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProjectIssueTypeScheme,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "issue_type_scheme_name",
+				Description: "The name of the issue type scheme assigned to the project.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProjectIssueTypeScheme,
+				Transform:   transform.FromField("Name"),
+			},
+
+			{
+				Name:        "recent",
+				Description: "Returns only the given number of projects most recently accessed by the user.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("recent"),
+			},
 
 			// json fields
 			{
@@ -99,6 +212,12 @@ func tableProject(_ context.Context) *plugin.Table {
 				Hydrate:     getProject,
 				Transform:   transform.FromField("Components").Transform(extractProjectComponentIds),
 			},
+			{
+				Name:        "insight",
+				Description: "Insights about the project, such as the total number of issues and the time of the last issue update.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Insight").NullIfZero(),
+			},
 			{
 				Name:        "issue_types",
 				Description: "List of the issue types available in the project.",
@@ -131,61 +250,85 @@ func listProjects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 		return nil, err
 	}
 
-	// If the requested number of items is less than the paging max limit
-	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
+	// The recently accessed projects are returned in a single page
+	if d.KeyColumnQuals["recent"] != nil {
+		return listRecentProjects(ctx, d, client, d.KeyColumnQuals["recent"].GetInt64Value())
 	}
 
-	query := ""
-	if d.KeyColumnQualString("key") != "" {
-		query = fmt.Sprintf("&%skeys=%s", query, d.KeyColumnQualString("key"))
+	isCloud, err := isCloudDeployment(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.listProjects", "server_info_error", err)
+		return nil, err
 	}
-	if d.KeyColumnQualString("project_type_key") != "" {
-		query = fmt.Sprintf("&%stypeKey=%s", query, d.KeyColumnQualString("project_type_key"))
+
+	err = searchProjects(ctx, d, client, getProjectSearchParams(d, isCloud), func(project Project) (bool, error) {
+		if isCloud {
+			setProjectStatusDefaults(&project)
+		}
+		d.StreamListItem(ctx, project)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.QueryStatus.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.listProjects", "api_error", err)
+		return nil, err
 	}
 
-	last := 0
-	for {
-		apiEndpoint := fmt.Sprintf(
-			"rest/api/3/project/search?expand=description,lead,issueTypes,url,projectKeys,permissions,insight&startAt=%d&maxResults=%d", last,
-			maxResults,
-		)
+	return nil, nil
+}
 
-		if query != "" {
-			apiEndpoint = fmt.Sprintf("%s%s", apiEndpoint, query)
-		}
+func listRecentProjects(ctx context.Context, d *plugin.QueryData, client *jira.Client, recent int64) (interface{}, error) {
+	projects, err := getRecentProjects(ctx, d, client, recent)
+	if err != nil {
+		return nil, err
+	}
 
-		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
-		if err != nil {
-			plugin.Logger(ctx).Error("jira_project.listProjects", "get_request_error", err)
-			return nil, err
+	for _, project := range projects {
+		d.StreamListItem(ctx, project)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
 		}
+	}
 
-		projectList := new(ProjectListResult)
-		_, err = client.Do(req, projectList)
-		if err != nil {
-			plugin.Logger(ctx).Error("jira_project.listProjects", "api_error", err)
-			return nil, err
-		}
+	return nil, nil
+}
 
-		for _, project := range projectList.Values {
-			d.StreamListItem(ctx, project)
-			// Context may get cancelled due to manual cancellation or if the limit has been reached
-			if d.QueryStatus.RowsRemaining(ctx) == 0 {
-				return nil, nil
-			}
-		}
-		last = projectList.StartAt + len(projectList.Values)
-		if projectList.IsLast {
-			return nil, nil
+// getRecentProjects:: returns the given number of projects most recently
+// accessed by the user
+func getRecentProjects(ctx context.Context, d *plugin.QueryData, client *jira.Client, recent int64) ([]Project, error) {
+	if recent <= 0 {
+		return nil, nil
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/project?expand=%s&recent=%d", getProjectExpand(d, "description,lead,issueTypes,url,projectKeys,permissions"), recent)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getRecentProjects", "get_request_error", err)
+		return nil, err
+	}
+
+	var projects []Project
+	_, err = doRequest(ctx, client, req, &projects)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getRecentProjects", "api_error", err)
+		return nil, err
+	}
+
+	isCloud, err := isCloudDeployment(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getRecentProjects", "server_info_error", err)
+		return nil, err
+	}
+
+	if isCloud {
+		for i := range projects {
+			setProjectStatusDefaults(&projects[i])
 		}
 	}
 
+	return projects, nil
 }
 
 //// HYDRATE FUNCTION
@@ -208,15 +351,15 @@ func getProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return nil, err
 	}
 
-	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s", projectId)
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s?expand=%s", projectId, getProjectExpand(d, "lead,description"))
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_project.getProject", "get_request_error", err)
 		return nil, err
 	}
 
 	project := new(Project)
-	_, err = client.Do(req, project)
+	_, err = doRequest(ctx, client, req, project)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -225,7 +368,219 @@ func getProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return nil, err
 	}
 
-	return project, err
+	isCloud, err := isCloudDeployment(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProject", "server_info_error", err)
+		return nil, err
+	}
+	if isCloud {
+		setProjectStatusDefaults(project)
+	}
+
+	return project, nil
+}
+
+func getProjectPermissionScheme(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId := projectIdFromHydrateItem(h.Item)
+	if projectId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProjectPermissionScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s/permissionscheme", projectId)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProjectPermissionScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	scheme := new(ProjectScheme)
+	_, err = doRequest(ctx, client, req, scheme)
+	if err != nil {
+		// Only administrators can view the scheme assigned to a project
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_project.getProjectPermissionScheme", "api_error", err)
+		return nil, err
+	}
+
+	return scheme, nil
+}
+
+func getProjectNotificationScheme(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId := projectIdFromHydrateItem(h.Item)
+	if projectId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProjectNotificationScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s/notificationscheme", projectId)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProjectNotificationScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	scheme := new(ProjectScheme)
+	_, err = doRequest(ctx, client, req, scheme)
+	if err != nil {
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_project.getProjectNotificationScheme", "api_error", err)
+		return nil, err
+	}
+
+	return scheme, nil
+}
+
+func getProjectIssueTypeScheme(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId := projectIdFromHydrateItem(h.Item)
+	if projectId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProjectIssueTypeScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issuetypescheme/project?projectId=%s", projectId)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProjectIssueTypeScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListIssueTypeSchemeProjectResult)
+	_, err = doRequest(ctx, client, req, listResult)
+	if err != nil {
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_project.getProjectIssueTypeScheme", "api_error", err)
+		return nil, err
+	}
+
+	// A project is assigned exactly one issue type scheme
+	if len(listResult.Values) == 0 {
+		return nil, nil
+	}
+
+	return listResult.Values[0].IssueTypeScheme, nil
+}
+
+// projectIdFromHydrateItem:: returns the project ID for both list (Project)
+// and get (*Project) rows
+func projectIdFromHydrateItem(item interface{}) string {
+	switch project := item.(type) {
+	case Project:
+		return project.ID
+	case *Project:
+		return project.ID
+	}
+	return ""
+}
+
+//// UTILITY FUNCTIONS
+
+// forEachProject:: calls fn with each project, until it returns false or an
+// error. Child tables of projects use it rather than listProjects as parent
+// hydrate, so that they can skip the listing when the project is given.
+func forEachProject(ctx context.Context, d *plugin.QueryData, client *jira.Client, fn func(Project) (bool, error)) error {
+	return searchProjects(ctx, d, client, url.Values{}, fn)
+}
+
+// getProjectSearchParams:: returns the project search params for the quals
+// of the query
+func getProjectSearchParams(d *plugin.QueryData, isCloud bool) url.Values {
+	params := url.Values{}
+	params.Set("expand", getProjectExpand(d, "description,lead,issueTypes,url,projectKeys,permissions"))
+	if d.KeyColumnQualString("key") != "" {
+		params.Set("keys", d.KeyColumnQualString("key"))
+	}
+	if d.KeyColumnQualString("project_type_key") != "" {
+		params.Set("typeKey", d.KeyColumnQualString("project_type_key"))
+	}
+
+	// Jira Cloud only returns live projects, unless asked for the archived and
+	// deleted ones too
+	if isCloud {
+		params.Set("status", "live,archived,deleted")
+	}
+
+	return params
+}
+
+// getProjectExpand:: adds insight to the expand of the project requests if
+// the insight column is selected, as it's expensive for Jira to compute
+func getProjectExpand(d *plugin.QueryData, expand string) string {
+	if isColumnRequested(d, "insight") {
+		return expand + ",insight"
+	}
+	return expand
+}
+
+// searchProjects:: pages through the projects matching the search params,
+// calling fn with each one until it returns false or an error
+func searchProjects(ctx context.Context, d *plugin.QueryData, client *jira.Client, params url.Values, fn func(Project) (bool, error)) error {
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	maxResults := getPageSize(d, 1000)
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/project/search?startAt=%d&maxResults=%d", last, maxResults)
+		if len(params) > 0 {
+			apiEndpoint = fmt.Sprintf("%s&%s", apiEndpoint, params.Encode())
+		}
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		projectList := new(ProjectListResult)
+		_, err = doRequest(ctx, client, req, projectList)
+		if err != nil {
+			return err
+		}
+
+		for _, project := range projectList.Values {
+			more, err := fn(project)
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		last = projectList.StartAt + len(projectList.Values)
+		if projectList.IsLast || len(projectList.Values) == 0 {
+			return nil
+		}
+	}
+}
+
+// setProjectStatusDefaults:: sets archived and deleted to false if they are
+// missing, as Jira Cloud only returns them for archived and deleted projects
+func setProjectStatusDefaults(project *Project) {
+	if project.Archived == nil {
+		project.Archived = new(bool)
+	}
+	if project.Deleted == nil {
+		project.Deleted = new(bool)
+	}
 }
 
 //// TRANSFORM FUNCTION
@@ -238,6 +593,16 @@ func extractProjectComponentIds(_ context.Context, d *transform.TransformData) (
 	return componentIds, nil
 }
 
+func countProjectItems(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch items := d.Value.(type) {
+	case []jira.ProjectComponent:
+		return len(items), nil
+	case []jira.Version:
+		return len(items), nil
+	}
+	return 0, nil
+}
+
 //// Custom Structs
 
 // type ProjectListResult []Project
@@ -268,4 +633,44 @@ type Project struct {
 	AvatarUrls      jira.AvatarUrls         `json:"avatarUrls,omitempty" structs:"avatarUrls,omitempty"`
 	ProjectCategory jira.ProjectCategory    `json:"projectCategory,omitempty" structs:"projectCategory,omitempty"`
 	ProjectTypeKey  string                  `json:"projectTypeKey" structs:"projectTypeKey"`
+	Insight         *ProjectInsight         `json:"insight,omitempty" structs:"insight,omitempty"`
+	Archived        *bool                   `json:"archived,omitempty" structs:"archived,omitempty"`
+	ArchivedDate    string                  `json:"archivedDate,omitempty" structs:"archivedDate,omitempty"`
+	Deleted         *bool                   `json:"deleted,omitempty" structs:"deleted,omitempty"`
+	DeletedDate     string                  `json:"deletedDate,omitempty" structs:"deletedDate,omitempty"`
+}
+
+// ProjectInsight holds the issue statistics of a project (Cloud only).
+type ProjectInsight struct {
+	TotalIssueCount     int64  `json:"totalIssueCount"`
+	LastIssueUpdateTime string `json:"lastIssueUpdateTime"`
+}
+
+// ProjectScheme is the scheme (permission, notification etc.) assigned to a project.
+type ProjectScheme struct {
+	ID          int64  `json:"id"`
+	Self        string `json:"self"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type ListIssueTypeSchemeProjectResult struct {
+	MaxResults int                       `json:"maxResults"`
+	StartAt    int                       `json:"startAt"`
+	Total      int                       `json:"total"`
+	IsLast     bool                      `json:"isLast"`
+	Values     []IssueTypeSchemeProjects `json:"values"`
+}
+
+type IssueTypeSchemeProjects struct {
+	IssueTypeScheme IssueTypeScheme `json:"issueTypeScheme"`
+	ProjectIds      []string        `json:"projectIds"`
+}
+
+type IssueTypeScheme struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	DefaultIssueTypeId string `json:"defaultIssueTypeId"`
+	IsDefault          bool   `json:"isDefault"`
 }
@@ -0,0 +1,129 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableProjectEmail(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_project_email",
+		Description: "The sender email address used for notifications and issue creation in a project.",
+		List: &plugin.ListConfig{
+			Hydrate: listProjectEmails,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "project_key", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_key",
+				Description: "The key of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_address",
+				Description: "The email address of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Email.EmailAddress"),
+			},
+
+			// json fields
+			{
+				Name:        "email_address_status",
+				Description: "When using a custom domain, the status of the email address.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Email.EmailAddressStatus"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Email.EmailAddress"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listProjectEmails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_email.listProjectEmails", "connection_error", err)
+		return nil, err
+	}
+
+	// Only fetch the email of the requested project, if one is given
+	projectKey := d.KeyColumnQualString("project_key")
+	if projectKey != "" {
+		// The email endpoint only accepts the numeric project id, not the key
+		project, _, err := client.Project.GetWithContext(ctx, projectKey)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_project_email.listProjectEmails", "get_project_error", err)
+			return nil, err
+		}
+		return nil, streamProjectEmail(ctx, d, client, project.Key, project.ID)
+	}
+
+	err = forEachProject(ctx, d, client, func(project Project) (bool, error) {
+		err := streamProjectEmail(ctx, d, client, project.Key, project.ID)
+		return d.QueryStatus.RowsRemaining(ctx) != 0, err
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_email.listProjectEmails", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamProjectEmail streams the sender email of a single project
+func streamProjectEmail(ctx context.Context, d *plugin.QueryData, client *jira.Client, projectKey string, projectId string) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s/email", projectId)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_email.streamProjectEmail", "get_request_error", err)
+		return err
+	}
+
+	email := new(ProjectEmail)
+	_, err = doRequest(ctx, client, req, email)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		plugin.Logger(ctx).Error("jira_project_email.streamProjectEmail", "api_error", err)
+		return err
+	}
+
+	d.StreamListItem(ctx, ProjectEmailInfo{projectKey, *email})
+
+	return nil
+}
+
+//// Custom Structs
+
+type ProjectEmail struct {
+	EmailAddress       string   `json:"emailAddress"`
+	EmailAddressStatus []string `json:"emailAddressStatus"`
+}
+
+type ProjectEmailInfo struct {
+	ProjectKey string
+	Email      ProjectEmail
+}
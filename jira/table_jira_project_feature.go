@@ -0,0 +1,150 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableProjectFeature(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_project_feature",
+		Description: "The features (backlog, sprints, roadmap etc.) that can be toggled for a team-managed project.",
+		List: &plugin.ListConfig{
+			Hydrate: listProjectFeatures,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "project_key", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_key",
+				Description: "The key of the project the feature belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "feature",
+				Description: "The key of the feature.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Feature.Feature"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the feature. Valid values are ENABLED, DISABLED and COMING_SOON.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Feature.State"),
+			},
+			{
+				Name:        "toggle_locked",
+				Description: "Whether the state of the feature can be updated.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Feature.ToggleLocked"),
+			},
+			{
+				Name:        "localized_name",
+				Description: "The localized name of the feature.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Feature.LocalisedName"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Feature.LocalisedName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listProjectFeatures(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_feature.listProjectFeatures", "connection_error", err)
+		return nil, err
+	}
+
+	// Only fetch the features of the requested project, if one is given
+	projectKey := d.KeyColumnQualString("project_key")
+	if projectKey != "" {
+		_, err = listFeaturesForProject(ctx, d, client, projectKey)
+		return nil, err
+	}
+
+	err = forEachProject(ctx, d, client, func(project Project) (bool, error) {
+		done, err := listFeaturesForProject(ctx, d, client, project.Key)
+		return !done, err
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_feature.listProjectFeatures", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// listFeaturesForProject streams the features of a single project and
+// reports whether the query limit has been reached
+func listFeaturesForProject(ctx context.Context, d *plugin.QueryData, client *jira.Client, projectKey string) (bool, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/project/%s/features", url.PathEscape(projectKey))
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_feature.listFeaturesForProject", "get_request_error", err)
+		return false, err
+	}
+
+	// Company-managed projects return an empty list of features
+	listResult := new(ListProjectFeatureResult)
+	_, err = doRequest(ctx, client, req, listResult)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		plugin.Logger(ctx).Error("jira_project_feature.listFeaturesForProject", "api_error", err)
+		return false, err
+	}
+
+	for _, feature := range listResult.Features {
+		d.StreamListItem(ctx, ProjectFeatureInfo{projectKey, feature})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+//// Custom Structs
+
+type ListProjectFeatureResult struct {
+	Features []ProjectFeature `json:"features"`
+}
+
+type ProjectFeature struct {
+	ProjectId            int64    `json:"projectId"`
+	Feature              string   `json:"feature"`
+	State                string   `json:"state"`
+	ToggleLocked         bool     `json:"toggleLocked"`
+	Prerequisites        []string `json:"prerequisites"`
+	LocalisedName        string   `json:"localisedName"`
+	LocalisedDescription string   `json:"localisedDescription"`
+	ImageUri             string   `json:"imageUri"`
+}
+
+type ProjectFeatureInfo struct {
+	ProjectKey string
+	Feature    ProjectFeature
+}
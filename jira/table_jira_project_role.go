@@ -110,7 +110,7 @@ func getProjectRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		return nil, nil
 	}
 
-	role, _, err := client.Role.Get(int(roleId))
+	role, _, err := client.Role.GetWithContext(ctx, int(roleId))
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -0,0 +1,215 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func TestGetProjectSearchParams(t *testing.T) {
+	d := newTestQueryData(nil, jiraConfig{})
+	d.KeyColumnQuals = map[string]*proto.QualValue{
+		"key": {Value: &proto.QualValue_StringValue{StringValue: "ENG"}},
+	}
+
+	params := getProjectSearchParams(d, true)
+	if params.Get("keys") != "ENG" {
+		t.Errorf("expected keys=ENG, got %q", params.Get("keys"))
+	}
+	if params.Get("status") != "live,archived,deleted" {
+		t.Errorf("expected all project statuses on Cloud, got %q", params.Get("status"))
+	}
+
+	// Jira Server doesn't support the status param
+	params = getProjectSearchParams(d, false)
+	if params.Has("status") {
+		t.Errorf("expected no status on Server, got %q", params.Get("status"))
+	}
+}
+
+func TestSearchProjectsArchived(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("status") != "live,archived,deleted" {
+			t.Errorf("expected the status param to be forwarded, got %q", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("startAt") == "0" {
+			fmt.Fprint(w, `{"startAt": 0, "maxResults": 2, "isLast": false, "values": [
+				{"id": "10000", "key": "ENG"},
+				{"id": "10001", "key": "OLD", "archived": true, "archivedDate": "2023-06-01T10:00:00.000+0000"}
+			]}`)
+			return
+		}
+		fmt.Fprint(w, `{"startAt": 2, "maxResults": 2, "isLast": true, "values": [
+			{"id": "10002", "key": "GONE", "deleted": true, "deletedDate": "2024-02-01T10:00:00.000+0000"}
+		]}`)
+	})
+
+	pageSize := 2
+	d := newTestQueryData(client, jiraConfig{PageSize: &pageSize})
+
+	projects := map[string]Project{}
+	err := searchProjects(newTestContext(), d, client, getProjectSearchParams(d, true), func(project Project) (bool, error) {
+		setProjectStatusDefaults(&project)
+		projects[project.Key] = project
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string][2]bool{"ENG": {false, false}, "OLD": {true, false}, "GONE": {false, true}}
+	for key, status := range expected {
+		project, ok := projects[key]
+		if !ok {
+			t.Errorf("expected project %s", key)
+			continue
+		}
+		if *project.Archived != status[0] || *project.Deleted != status[1] {
+			t.Errorf("expected archived %v and deleted %v for %s, got %v and %v", status[0], status[1], key, *project.Archived, *project.Deleted)
+		}
+	}
+	if projects["OLD"].ArchivedDate == "" {
+		t.Error("expected the archived date of OLD")
+	}
+}
+
+func TestGetProjectExpand(t *testing.T) {
+	d := newTestQueryData(nil, jiraConfig{})
+
+	d.QueryContext.Columns = []string{"key", "name"}
+	if expand := getProjectSearchParams(d, true).Get("expand"); strings.Contains(expand, "insight") {
+		t.Errorf("expected no insight expand, got %q", expand)
+	}
+
+	d.QueryContext.Columns = []string{"key", "insight"}
+	if expand := getProjectSearchParams(d, true).Get("expand"); !strings.HasSuffix(expand, ",insight") {
+		t.Errorf("expected the insight expand, got %q", expand)
+	}
+}
+
+func TestGetRecentProjects(t *testing.T) {
+	var recent []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/serverInfo":
+			fmt.Fprint(w, `{"deploymentType": "Cloud"}`)
+		case "/rest/api/2/project":
+			recent = append(recent, r.URL.Query().Get("recent"))
+			fmt.Fprint(w, `[{"id": "10000", "key": "ENG"}, {"id": "10001", "key": "OPS"}]`)
+		default:
+			// The full project search isn't paged through
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+	d := newTestQueryData(client, jiraConfig{})
+
+	projects, err := getRecentProjects(newTestContext(), d, client, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(recent) != 1 || recent[0] != "2" {
+		t.Errorf("expected one request with recent=2, got %v", recent)
+	}
+	if len(projects) != 2 || projects[0].Key != "ENG" || projects[1].Key != "OPS" {
+		t.Errorf("expected the recent projects, got %v", projects)
+	}
+}
+
+func TestProjectItemCounts(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/serverInfo":
+			fmt.Fprint(w, `{"deploymentType": "Server"}`)
+		case "/rest/api/2/project/10000":
+			fmt.Fprint(w, `{
+				"id": "10000",
+				"key": "ENG",
+				"components": [{"id": "10100", "name": "Backend"}, {"id": "10101", "name": "Frontend"}],
+				"versions": [{"id": "10200", "name": "1.0"}, {"id": "10201", "name": "1.1"}, {"id": "10202", "name": "2.0"}]
+			}`)
+		case "/rest/api/2/project/10001":
+			fmt.Fprint(w, `{"id": "10001", "key": "OPS", "components": [], "versions": []}`)
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+	d := newTestQueryData(client, jiraConfig{})
+
+	tests := []struct {
+		id                 string
+		expectedComponents int
+		expectedVersions   int
+	}{
+		{"10000", 2, 3},
+		{"10001", 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			item, err := getProject(newTestContext(), d, &plugin.HydrateData{Item: Project{ID: test.id}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			project := item.(*Project)
+
+			componentCount, _ := countProjectItems(context.Background(), &transform.TransformData{Value: project.Components})
+			versionCount, _ := countProjectItems(context.Background(), &transform.TransformData{Value: project.Versions})
+			if componentCount != test.expectedComponents || versionCount != test.expectedVersions {
+				t.Errorf("expected %d components and %d versions, got %v and %v", test.expectedComponents, test.expectedVersions, componentCount, versionCount)
+			}
+		})
+	}
+}
+
+func TestProjectInactiveLead(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/serverInfo":
+			fmt.Fprint(w, `{"deploymentType": "Cloud"}`)
+		case "/rest/api/2/project/10000":
+			if expand := r.URL.Query().Get("expand"); !strings.Contains(expand, "lead") {
+				t.Errorf("expected the lead expand, got %q", expand)
+			}
+			fmt.Fprint(w, `{
+				"id": "10000",
+				"key": "ENG",
+				"lead": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Former Lead", "active": false}
+			}`)
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	})
+	d := newTestQueryData(client, jiraConfig{})
+
+	project, err := getProject(newTestContext(), d, &plugin.HydrateData{Item: Project{ID: "10000"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]interface{}{"lead_active": false, "lead_display_name": "Former Lead"}
+	for _, column := range tableProject(context.Background()).Columns {
+		expectedValue, ok := expected[column.Name]
+		if !ok {
+			continue
+		}
+		delete(expected, column.Name)
+
+		actual, err := column.Transform.Execute(context.Background(), &transform.TransformData{HydrateItem: project, ColumnName: column.Name}, transform.FromCamel())
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", column.Name, err)
+		}
+		if actual != expectedValue {
+			t.Errorf("expected %s %v, got %v", column.Name, expectedValue, actual)
+		}
+	}
+	for column := range expected {
+		t.Errorf("column %s not found", column)
+	}
+}
@@ -0,0 +1,143 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableProjectType(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_project_type",
+		Description: "The project types supported by the Jira instance, e.g. software and business.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("key"),
+			Hydrate:    getProjectType,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listProjectTypes,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "key",
+				Description: "The key of the project type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "formatted_key",
+				Description: "The formatted key of the project type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "descriptive_key",
+				Description: "The key of the description of the project type, used to look up its translation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DescriptionI18nKey"),
+			},
+			{
+				Name:        "color",
+				Description: "The color of the project type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "icon",
+				Description: "The icon of the project type, as a base64 encoded SVG.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FormattedKey"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listProjectTypes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_type.listProjectTypes", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "rest/api/2/project/type", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_type.listProjectTypes", "get_request_error", err)
+		return nil, err
+	}
+
+	projectTypes := new([]ProjectType)
+	_, err = doRequest(ctx, client, req, projectTypes)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_type.listProjectTypes", "api_error", err)
+		return nil, err
+	}
+
+	for _, projectType := range *projectTypes {
+		d.StreamListItem(ctx, projectType)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getProjectType(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := d.KeyColumnQuals["key"].GetStringValue()
+
+	// Return nil, if no input provided
+	if key == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_type.getProjectType", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/type/%s", url.PathEscape(key))
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_type.getProjectType", "get_request_error", err)
+		return nil, err
+	}
+
+	result := new(ProjectType)
+	_, err = doRequest(ctx, client, req, result)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_project_type.getProjectType", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//// Custom Structs
+
+type ProjectType struct {
+	Key                string `json:"key"`
+	FormattedKey       string `json:"formattedKey"`
+	DescriptionI18nKey string `json:"descriptionI18nKey"`
+	Color              string `json:"color"`
+	Icon               string `json:"icon"`
+}
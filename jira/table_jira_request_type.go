@@ -0,0 +1,167 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableRequestType(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_request_type",
+		Description: "Request types that customers can raise in a Jira Service Management service desk.",
+		List: &plugin.ListConfig{
+			Hydrate: listRequestTypes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "service_desk_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_desk_id",
+				Description: "The ID of the service desk the request type belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the request type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the request type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the request type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "help_text",
+				Description: "The help text shown for the request type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HelpText").NullIfZero(),
+			},
+			{
+				Name:        "issue_type_id",
+				Description: "The ID of the issue type the request type is based on.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "group_ids",
+				Description: "The IDs of the groups the request type belongs to on the customer portal.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRequestTypes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_request_type.listRequestTypes", "connection_error", err)
+		return nil, err
+	}
+
+	// Only fetch the request types of the requested service desk, if one is given
+	serviceDeskId := d.KeyColumnQualString("service_desk_id")
+	if serviceDeskId != "" {
+		_, err = listRequestTypesForServiceDesk(ctx, d, client, serviceDeskId)
+		return nil, err
+	}
+
+	err = forEachServiceDesk(ctx, d, client, func(serviceDesk ServiceDesk) (bool, error) {
+		done, err := listRequestTypesForServiceDesk(ctx, d, client, serviceDesk.Id)
+		return !done, err
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_request_type.listRequestTypes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// listRequestTypesForServiceDesk streams the request types of a single
+// service desk and reports whether the query limit has been reached
+func listRequestTypesForServiceDesk(ctx context.Context, d *plugin.QueryData, client *jira.Client, serviceDeskId string) (bool, error) {
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := getPageSize(d, 100)
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/servicedeskapi/servicedesk/%s/requesttype?start=%d&limit=%d", url.PathEscape(serviceDeskId), last, limit)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_request_type.listRequestTypesForServiceDesk", "get_request_error", err)
+			return false, err
+		}
+
+		listResult := new(ListRequestTypeResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				return false, nil
+			}
+			plugin.Logger(ctx).Error("jira_request_type.listRequestTypesForServiceDesk", "api_error", err)
+			return false, err
+		}
+
+		for _, requestType := range listResult.Values {
+			d.StreamListItem(ctx, requestType)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return true, nil
+			}
+		}
+
+		last = listResult.Start + len(listResult.Values)
+		if listResult.IsLastPage || len(listResult.Values) == 0 {
+			return false, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListRequestTypeResult struct {
+	Size       int           `json:"size"`
+	Start      int           `json:"start"`
+	Limit      int           `json:"limit"`
+	IsLastPage bool          `json:"isLastPage"`
+	Values     []RequestType `json:"values"`
+}
+
+type RequestType struct {
+	Id            string   `json:"id"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	HelpText      string   `json:"helpText"`
+	IssueTypeId   string   `json:"issueTypeId"`
+	ServiceDeskId string   `json:"serviceDeskId"`
+	GroupIds      []string `json:"groupIds"`
+}
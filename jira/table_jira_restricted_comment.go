@@ -0,0 +1,253 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableRestrictedComment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_restricted_comment",
+		Description: "Issue comments whose visibility is restricted to a group or a project role.",
+		List: &plugin.ListConfig{
+			Hydrate: listRestrictedComments,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "jql", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "author_account_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue the comment belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment_id",
+				Description: "The ID of the comment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Comment.ID"),
+			},
+			{
+				Name:        "visibility_type",
+				Description: "Whether the comment is restricted to a group or a project role.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Comment.Visibility.Type"),
+			},
+			{
+				Name:        "visibility_value",
+				Description: "The name of the group or project role the comment is restricted to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Comment.Visibility.Value"),
+			},
+			{
+				Name:        "author_account_id",
+				Description: "Account Id of the user who wrote the comment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Comment.Author.AccountID"),
+			},
+			{
+				Name:        "author_display_name",
+				Description: "Display name of the user who wrote the comment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Comment.Author.DisplayName"),
+			},
+			{
+				Name:        "created",
+				Description: "Time when the comment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Comment.Created").Transform(convertJiraTime),
+			},
+			{
+				Name:        "updated",
+				Description: "Time when the comment was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Comment.Updated").Transform(convertJiraTime),
+			},
+			{
+				Name:        "jql",
+				Description: "A JQL query to filter the issues with. This is AND-combined with the default_jql of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("jql"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Comment.ID"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRestrictedComments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_restricted_comment.listRestrictedComments", "connection_error", err)
+		return nil, err
+	}
+
+	jiraConfig := GetConfig(d.Connection)
+	var defaultJQL string
+	if jiraConfig.DefaultJQL != nil {
+		defaultJQL = *jiraConfig.DefaultJQL
+	}
+	jql, err := combineJQL(defaultJQL, d.KeyColumnQualString("jql"))
+	if err != nil {
+		return nil, err
+	}
+	authorAccountId := d.KeyColumnQualString("author_account_id")
+
+	last := 0
+	maxResults := getPageSize(d, 100)
+	for {
+		params := url.Values{}
+		params.Set("jql", jql)
+		params.Set("startAt", strconv.Itoa(last))
+		params.Set("maxResults", strconv.Itoa(maxResults))
+		params.Set("fields", "comment")
+
+		// The search returns the first page of comments of each issue, so the
+		// comment endpoint is only called for issues with more comments
+		apiEndpoint := fmt.Sprintf("/rest/api/2/search?%s", params.Encode())
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_restricted_comment.listRestrictedComments", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(RestrictedCommentSearchResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_restricted_comment.listRestrictedComments", "api_error", err)
+			return nil, err
+		}
+
+		for _, issue := range listResult.Issues {
+			comments := issue.Fields.Comment.Comments
+			if len(comments) < issue.Fields.Comment.Total {
+				comments, err = listIssueComments(ctx, client, issue.Key)
+				if err != nil {
+					plugin.Logger(ctx).Error("jira_restricted_comment.listRestrictedComments", "api_error", err, "issue_key", issue.Key)
+					return nil, err
+				}
+			}
+
+			for _, comment := range filterRestrictedComments(comments, authorAccountId) {
+				d.StreamListItem(ctx, RestrictedCommentInfo{issue.Key, comment})
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Issues)
+		if last >= listResult.Total {
+			return nil, nil
+		}
+	}
+}
+
+//// UTILITY FUNCTIONS
+
+// filterRestrictedComments:: returns the comments with a visibility
+// restriction, written by the given author if one is given. The comment API
+// can't filter by author, so it is done here.
+func filterRestrictedComments(comments []RestrictedComment, authorAccountId string) []RestrictedComment {
+	var restricted []RestrictedComment
+	for _, comment := range comments {
+		if comment.Visibility == nil || comment.Visibility.Type == "" {
+			continue
+		}
+		if authorAccountId != "" && comment.Author.AccountID != authorAccountId {
+			continue
+		}
+		restricted = append(restricted, comment)
+	}
+	return restricted
+}
+
+// listIssueComments:: returns all the comments of an issue. The issues are
+// processed one at a time, which caps the requests to one per issue.
+func listIssueComments(ctx context.Context, client *jira.Client, issueKey string) ([]RestrictedComment, error) {
+	var comments []RestrictedComment
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/comment?startAt=%d&maxResults=%d", url.PathEscape(issueKey), last, 5000)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		page := new(RestrictedCommentPage)
+		_, err = doRequest(ctx, client, req, page)
+		if err != nil {
+			if isNotFoundError(err) {
+				return comments, nil
+			}
+			return nil, err
+		}
+
+		comments = append(comments, page.Comments...)
+
+		last = page.StartAt + len(page.Comments)
+		if len(page.Comments) == 0 || last >= page.Total {
+			return comments, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type RestrictedCommentSearchResult struct {
+	StartAt    int `json:"startAt"`
+	MaxResults int `json:"maxResults"`
+	Total      int `json:"total"`
+	Issues     []struct {
+		ID     string `json:"id"`
+		Key    string `json:"key"`
+		Fields struct {
+			Comment RestrictedCommentPage `json:"comment"`
+		} `json:"fields"`
+	} `json:"issues"`
+}
+
+type RestrictedCommentPage struct {
+	StartAt    int                 `json:"startAt"`
+	MaxResults int                 `json:"maxResults"`
+	Total      int                 `json:"total"`
+	Comments   []RestrictedComment `json:"comments"`
+}
+
+// RestrictedComment is a jira.Comment with the dates decoded as jira.Time
+type RestrictedComment struct {
+	ID         string                  `json:"id"`
+	Self       string                  `json:"self"`
+	Author     jira.User               `json:"author"`
+	Created    jira.Time               `json:"created"`
+	Updated    jira.Time               `json:"updated"`
+	Visibility *jira.CommentVisibility `json:"visibility,omitempty"`
+}
+
+type RestrictedCommentInfo struct {
+	IssueKey string
+	Comment  RestrictedComment
+}
@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFilterRestrictedComments(t *testing.T) {
+	fixture := `{
+		"startAt": 0,
+		"maxResults": 5000,
+		"total": 5,
+		"comments": [
+			{"id": "1", "author": {"accountId": "alice"}, "visibility": {"type": "role", "value": "Administrators"}},
+			{"id": "2", "author": {"accountId": "alice"}},
+			{"id": "3", "author": {"accountId": "bob"}, "visibility": {"type": "group", "value": "jira-developers"}},
+			{"id": "4", "author": {"accountId": "alice"}, "visibility": {"type": "group", "value": "jira-developers"}},
+			{"id": "5", "author": {"accountId": "bob"}}
+		]
+	}`
+
+	var page RestrictedCommentPage
+	if err := json.Unmarshal([]byte(fixture), &page); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		authorAccountId string
+		expected        []string
+	}{
+		{"all authors", "", []string{"1", "3", "4"}},
+		{"single author", "alice", []string{"1", "4"}},
+		{"other author", "bob", []string{"3"}},
+		{"unknown author", "carol", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var actual []string
+			for _, comment := range filterRestrictedComments(page.Comments, test.authorAccountId) {
+				actual = append(actual, comment.ID)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
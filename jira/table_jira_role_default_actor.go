@@ -0,0 +1,183 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableRoleDefaultActor(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_role_default_actor",
+		Description: "The default actors of project roles, which are added to the role when a project is created.",
+		List: &plugin.ListConfig{
+			Hydrate: listRoleDefaultActors,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "role_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "role_id",
+				Description: "The ID of the project role.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "actor_id",
+				Description: "The ID of the role actor.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Actor.ID"),
+			},
+			{
+				Name:        "actor_type",
+				Description: "The type of the actor, atlassian-user-role-actor for users and atlassian-group-role-actor for groups.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.Type"),
+			},
+			{
+				Name:        "actor_account_id",
+				Description: "The account ID of the user, for user actors.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.ActorUser.AccountID"),
+			},
+			{
+				Name:        "actor_group_name",
+				Description: "The name of the group, for group actors.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(extractRoleActorGroupName),
+			},
+			{
+				Name:        "actor_display_name",
+				Description: "The display name of the user or group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.DisplayName"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.DisplayName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoleDefaultActors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_role_default_actor.listRoleDefaultActors", "connection_error", err)
+		return nil, err
+	}
+
+	var roleIds []int64
+	if d.KeyColumnQuals["role_id"] != nil {
+		roleIds = append(roleIds, d.KeyColumnQuals["role_id"].GetInt64Value())
+	} else {
+		roles, _, err := client.Role.GetListWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_role_default_actor.listRoleDefaultActors", "api_error", err)
+			return nil, err
+		}
+		for _, role := range *roles {
+			roleIds = append(roleIds, int64(role.ID))
+		}
+	}
+
+	for _, roleId := range roleIds {
+		actors, err := getRoleDefaultActors(ctx, client, roleId)
+		if err != nil {
+			// Only administrators can view the default actors
+			if isForbiddenError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_role_default_actor.listRoleDefaultActors", "api_error", err)
+			return nil, err
+		}
+
+		for _, actor := range actors {
+			d.StreamListItem(ctx, RoleDefaultActorInfo{roleId, actor})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func extractRoleActorGroupName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	actor := d.HydrateItem.(RoleDefaultActorInfo).Actor
+	if actor.ActorGroup != nil {
+		return actor.ActorGroup.Name, nil
+	}
+	// Older versions only return the group name as the name of the actor
+	if actor.Type == "atlassian-group-role-actor" {
+		return actor.Name, nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// getRoleDefaultActors:: returns the default actors of the role. A role that
+// no longer exists has no actors.
+func getRoleDefaultActors(ctx context.Context, client *jira.Client, roleId int64) ([]RoleActor, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/role/%d/actors", roleId)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(RoleDefaultActors)
+	_, err = doRequest(ctx, client, req, result)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return result.Actors, nil
+}
+
+//// Custom Structs
+
+type RoleDefaultActors struct {
+	Actors []RoleActor `json:"actors"`
+}
+
+// RoleActor is a jira.Actor with the group details of group actors
+type RoleActor struct {
+	ID          int64           `json:"id"`
+	DisplayName string          `json:"displayName"`
+	Type        string          `json:"type"`
+	Name        string          `json:"name"`
+	ActorUser   *jira.ActorUser `json:"actorUser"`
+	ActorGroup  *RoleActorGroup `json:"actorGroup"`
+}
+
+type RoleActorGroup struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	GroupId     string `json:"groupId"`
+}
+
+type RoleDefaultActorInfo struct {
+	RoleId int64
+	Actor  RoleActor
+}
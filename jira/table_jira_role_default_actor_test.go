@@ -0,0 +1,84 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func TestGetRoleDefaultActors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/role/10002/actors" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"actors": [
+			{"id": 10240, "displayName": "Jane Admin", "type": "atlassian-user-role-actor", "actorUser": {"accountId": "5b10a2844c20165700ede21g"}},
+			{"id": 10241, "displayName": "jira-administrators", "type": "atlassian-group-role-actor", "name": "jira-administrators",
+				"actorGroup": {"name": "jira-administrators", "displayName": "jira-administrators", "groupId": "6e87dc72-4f1f-421f-9382-2fee8b652487"}},
+			{"id": 10242, "displayName": "jira-users", "type": "atlassian-group-role-actor", "name": "jira-users"}
+		]}`)
+	})
+
+	actors, err := getRoleDefaultActors(newTestContext(), client, 10002)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []struct {
+		actorType string
+		accountId string
+		groupName interface{}
+	}{
+		{"atlassian-user-role-actor", "5b10a2844c20165700ede21g", nil},
+		{"atlassian-group-role-actor", "", "jira-administrators"},
+		// Older versions only return the group name as the actor name
+		{"atlassian-group-role-actor", "", "jira-users"},
+	}
+	if len(actors) != len(expected) {
+		t.Fatalf("expected %d actors, got %d", len(expected), len(actors))
+	}
+	for i, actor := range actors {
+		if actor.Type != expected[i].actorType {
+			t.Errorf("expected type %s for %s, got %s", expected[i].actorType, actor.DisplayName, actor.Type)
+		}
+		var accountId string
+		if actor.ActorUser != nil {
+			accountId = actor.ActorUser.AccountID
+		}
+		if accountId != expected[i].accountId {
+			t.Errorf("expected account %q for %s, got %q", expected[i].accountId, actor.DisplayName, accountId)
+		}
+		groupName, _ := extractRoleActorGroupName(context.Background(), &transform.TransformData{HydrateItem: RoleDefaultActorInfo{10002, actor}})
+		if groupName != expected[i].groupName {
+			t.Errorf("expected group %v for %s, got %v", expected[i].groupName, actor.DisplayName, groupName)
+		}
+	}
+
+	// A role that no longer exists has no actors
+	actors, err = getRoleDefaultActors(newTestContext(), client, 99999)
+	if err != nil || actors != nil {
+		t.Errorf("expected no actors for a missing role, got %v (error %v)", actors, err)
+	}
+}
+
+func TestListRoleDefaultActorsForbidden(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages": ["You are not authorized to perform this action. Administrator privileges are required."], "errors": {}}`)
+	})
+	d := newTestQueryData(client, jiraConfig{})
+	d.KeyColumnQuals = map[string]*proto.QualValue{
+		"role_id": {Value: &proto.QualValue_Int64Value{Int64Value: 10002}},
+	}
+
+	// Without admin permissions, the table is empty rather than failing
+	_, err := listRoleDefaultActors(newTestContext(), d, nil)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
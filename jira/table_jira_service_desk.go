@@ -0,0 +1,151 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableServiceDesk(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_service_desk",
+		Description: "Service desks of Jira Service Management.",
+		List: &plugin.ListConfig{
+			Hydrate: listServiceDesks,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the service desk.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project of the service desk.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_key",
+				Description: "The key of the project of the service desk.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_name",
+				Description: "The name of the project of the service desk.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the service desk.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Links.Self"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listServiceDesks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_service_desk.listServiceDesks", "connection_error", err)
+		return nil, err
+	}
+
+	err = forEachServiceDesk(ctx, d, client, func(serviceDesk ServiceDesk) (bool, error) {
+		d.StreamListItem(ctx, serviceDesk)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.QueryStatus.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_service_desk.listServiceDesks", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// forEachServiceDesk:: calls fn with each service desk, until it returns
+// false or an error. Child tables of service desks use it rather than
+// listServiceDesks as parent hydrate, so that they can skip the listing when
+// the service desk is given.
+func forEachServiceDesk(ctx context.Context, d *plugin.QueryData, client *jira.Client, fn func(ServiceDesk) (bool, error)) error {
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := getPageSize(d, 100)
+
+	// The Service Management API pages with start and limit, not startAt
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/servicedeskapi/servicedesk?start=%d&limit=%d", last, limit)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		listResult := new(ListServiceDeskResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				plugin.Logger(ctx).Info("jira_service_desk.forEachServiceDesk", "Jira Service Management is not installed, no rows returned")
+				return nil
+			}
+			return err
+		}
+
+		for _, serviceDesk := range listResult.Values {
+			more, err := fn(serviceDesk)
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		last = listResult.Start + len(listResult.Values)
+		if listResult.IsLastPage || len(listResult.Values) == 0 {
+			return nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListServiceDeskResult struct {
+	Size       int           `json:"size"`
+	Start      int           `json:"start"`
+	Limit      int           `json:"limit"`
+	IsLastPage bool          `json:"isLastPage"`
+	Values     []ServiceDesk `json:"values"`
+}
+
+type ServiceDesk struct {
+	Id          string           `json:"id"`
+	ProjectId   string           `json:"projectId"`
+	ProjectName string           `json:"projectName"`
+	ProjectKey  string           `json:"projectKey"`
+	Links       ServiceDeskLinks `json:"_links"`
+}
+
+// ServiceDeskLinks is the _links object of the Service Management API
+type ServiceDeskLinks struct {
+	Self string `json:"self"`
+}
@@ -0,0 +1,176 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableSla(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_sla",
+		Description: "The SLAs of a Jira Service Management request.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSlas,
+			KeyColumns: plugin.SingleColumn("issue_key"),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "issue_key",
+				Description: "The key of the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the SLA.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sla.Id"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the SLA.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sla.Name"),
+			},
+			{
+				Name:        "ongoing_breached",
+				Description: "Whether the ongoing cycle of the SLA has breached its goal. Null if there is no ongoing cycle.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Sla.OngoingCycle.Breached"),
+			},
+			{
+				Name:        "completed_breached",
+				Description: "Whether any completed cycle of the SLA breached its goal.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(slaCompletedBreached),
+			},
+
+			// json fields
+			{
+				Name:        "ongoing_cycle",
+				Description: "The ongoing cycle of the SLA, including the goal, elapsed and remaining time.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Sla.OngoingCycle"),
+			},
+			{
+				Name:        "completed_cycles",
+				Description: "The completed cycles of the SLA.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Sla.CompletedCycles"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sla.Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSlas(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	issueKey := d.KeyColumnQualString("issue_key")
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_sla.listSlas", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := getPageSize(d, 100)
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/servicedeskapi/request/%s/sla?start=%d&limit=%d", url.PathEscape(issueKey), last, limit)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_sla.listSlas", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListSlaResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			// The issue doesn't exist, isn't a request, or Service Management
+			// isn't installed
+			if isNotFoundError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_sla.listSlas", "api_error", err)
+			return nil, err
+		}
+
+		for _, sla := range listResult.Values {
+			d.StreamListItem(ctx, SlaInfo{issueKey, sla})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.Start + len(listResult.Values)
+		if listResult.IsLastPage {
+			return nil, nil
+		}
+	}
+}
+
+//// TRANSFORM FUNCTION
+
+func slaCompletedBreached(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	sla := d.HydrateItem.(SlaInfo).Sla
+	for _, cycle := range sla.CompletedCycles {
+		if cycle.Breached {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+//// Custom Structs
+
+type ListSlaResult struct {
+	Size       int   `json:"size"`
+	Start      int   `json:"start"`
+	Limit      int   `json:"limit"`
+	IsLastPage bool  `json:"isLastPage"`
+	Values     []Sla `json:"values"`
+}
+
+type Sla struct {
+	Id              string     `json:"id"`
+	Name            string     `json:"name"`
+	OngoingCycle    *SlaCycle  `json:"ongoingCycle,omitempty"`
+	CompletedCycles []SlaCycle `json:"completedCycles"`
+}
+
+type SlaCycle struct {
+	StartTime           interface{} `json:"startTime,omitempty"`
+	StopTime            interface{} `json:"stopTime,omitempty"`
+	BreachTime          interface{} `json:"breachTime,omitempty"`
+	Breached            bool        `json:"breached"`
+	Paused              bool        `json:"paused,omitempty"`
+	WithinCalendarHours bool        `json:"withinCalendarHours,omitempty"`
+	GoalDuration        interface{} `json:"goalDuration,omitempty"`
+	ElapsedTime         interface{} `json:"elapsedTime,omitempty"`
+	RemainingTime       interface{} `json:"remainingTime,omitempty"`
+}
+
+type SlaInfo struct {
+	IssueKey string
+	Sla      Sla
+}
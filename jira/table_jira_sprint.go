@@ -26,6 +26,10 @@ func tableSprint(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listBoards,
 			Hydrate:       listSprints,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "start_date", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "complete_date", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -97,13 +101,7 @@ func listSprints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
-	}
+	maxResults := getPageSize(d, 1000)
 
 	last := 0
 	for {
@@ -114,14 +112,14 @@ func listSprints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 			maxResults,
 		)
 
-		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_sprint.listSprints", "get_request_error", err)
 			return nil, err
 		}
 
 		listResult := new(ListSprintResult)
-		_, err = client.Do(req, listResult)
+		_, err = doRequest(ctx, client, req, listResult)
 		if err != nil {
 			if isNotFoundError(err) {
 				return nil, nil
@@ -131,6 +129,10 @@ func listSprints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 		}
 
 		for _, sprint := range listResult.Values {
+			// The agile API doesn't support date filters, so apply them here
+			if !sprintMatchesDateQuals(d, sprint) {
+				continue
+			}
 			d.StreamListItem(ctx, SprintItemInfo{int64(board.ID), sprint})
 			// Context may get cancelled due to manual cancellation or if the limit has been reached
 			if d.QueryStatus.RowsRemaining(ctx) == 0 {
@@ -145,6 +147,55 @@ func listSprints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	}
 }
 
+//// UTILITY FUNCTIONS
+
+// sprintMatchesDateQuals:: checks the sprint dates against the start_date and
+// complete_date quals. Sprints without the date never match a qual on it.
+func sprintMatchesDateQuals(d *plugin.QueryData, sprint Sprint) bool {
+	dates := map[string]time.Time{
+		"start_date":    sprint.StartDate,
+		"complete_date": sprint.CompleteDate,
+	}
+
+	for column, date := range dates {
+		if d.Quals[column] == nil {
+			continue
+		}
+		for _, q := range d.Quals[column].Quals {
+			if date.IsZero() {
+				return false
+			}
+			value := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">":
+				if !date.After(value) {
+					return false
+				}
+			case ">=":
+				if date.Before(value) {
+					return false
+				}
+			case "=":
+				if !date.Equal(value) {
+					return false
+				}
+			case "<":
+				if !date.Before(value) {
+					return false
+				}
+			case "<=":
+				if date.After(value) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+//// Custom Structs
+
 type ListSprintResult struct {
 	MaxResults int      `json:"maxResults"`
 	StartAt    int      `json:"startAt"`
@@ -0,0 +1,241 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableSprintReport(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_sprint_report",
+		Description: "Velocity metrics of a sprint, as shown in the sprint report of a board.",
+		List: &plugin.ListConfig{
+			Hydrate: listSprintReports,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "board_id", Require: plugin.Required},
+				{Name: "sprint_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "board_id",
+				Description: "The ID of the board.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "sprint_id",
+				Description: "The ID of the sprint.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "sprint_name",
+				Description: "The name of the sprint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Report.Sprint.Name"),
+			},
+			{
+				Name:        "completed_points",
+				Description: "The sum of the estimates of the issues completed in the sprint.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Report.Contents.CompletedIssuesEstimateSum.Value"),
+			},
+			{
+				Name:        "incompleted_points",
+				Description: "The sum of the estimates of the issues not completed in the sprint.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Report.Contents.IssuesNotCompletedEstimateSum.Value"),
+			},
+			{
+				Name:        "all_issues_estimate_sum",
+				Description: "The sum of the estimates of all the issues in the sprint.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Report.Contents.AllIssuesEstimateSum.Value"),
+			},
+
+			// json fields
+			{
+				Name:        "completed_issues",
+				Description: "The issues completed in the sprint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Report.Contents.CompletedIssues"),
+			},
+			{
+				Name:        "issues_not_completed",
+				Description: "The issues not completed in the sprint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Report.Contents.IssuesNotCompletedInCurrentSprint"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Report.Sprint.Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSprintReports(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	boardId := d.KeyColumnQuals["board_id"].GetInt64Value()
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_sprint_report.listSprintReports", "connection_error", err)
+		return nil, err
+	}
+
+	// The sprint report is served by an undocumented endpoint, so only call
+	// it on the deployments it's known to be on
+	available, err := isSprintReportAvailable(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_sprint_report.listSprintReports", "server_info_error", err)
+		return nil, err
+	}
+	if !available {
+		plugin.Logger(ctx).Info("jira_sprint_report.listSprintReports", "The sprint report is not available on this deployment, no rows returned")
+		return nil, nil
+	}
+
+	// The endpoint isn't guaranteed to be available even then, so a 404
+	// means there is no report
+	if d.KeyColumnQuals["sprint_id"] != nil {
+		sprintId := d.KeyColumnQuals["sprint_id"].GetInt64Value()
+		report, err := getSprintReport(ctx, client, boardId, sprintId)
+		if err != nil {
+			if isNotFoundError(err) {
+				plugin.Logger(ctx).Warn("jira_sprint_report.listSprintReports", "board_id", boardId, "sprint_id", sprintId, "report_not_found", err)
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_sprint_report.listSprintReports", "api_error", err)
+			return nil, err
+		}
+		d.StreamListItem(ctx, SprintReportInfo{boardId, sprintId, *report})
+		return nil, nil
+	}
+
+	// Only the board is given, so report on each of its sprints
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"/rest/agile/1.0/board/%d/sprint?startAt=%d",
+			boardId,
+			last,
+		)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_sprint_report.listSprintReports", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListSprintResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_sprint_report.listSprintReports", "api_error", err)
+			return nil, err
+		}
+
+		for _, sprint := range listResult.Values {
+			report, err := getSprintReport(ctx, client, boardId, sprint.Id)
+			if err != nil {
+				// Skip the sprint, rather than the rest of the board
+				if isNotFoundError(err) {
+					plugin.Logger(ctx).Warn("jira_sprint_report.listSprintReports", "board_id", boardId, "sprint_id", sprint.Id, "report_not_found", err)
+					continue
+				}
+				plugin.Logger(ctx).Error("jira_sprint_report.listSprintReports", "api_error", err)
+				return nil, err
+			}
+
+			d.StreamListItem(ctx, SprintReportInfo{boardId, sprint.Id, *report})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast {
+			return nil, nil
+		}
+	}
+}
+
+// isSprintReportAvailable:: checks if the deployment is one that serves the
+// greenhopper sprint report, i.e. Cloud, or Server and Data Center, which
+// both report a Server deployment type
+func isSprintReportAvailable(ctx context.Context, d *plugin.QueryData) (bool, error) {
+	serverInfo, err := getServerInfo(ctx, d)
+	if err != nil {
+		return false, err
+	}
+	return serverInfo.DeploymentType == "Cloud" || serverInfo.DeploymentType == "Server", nil
+}
+
+func getSprintReport(ctx context.Context, client *jira.Client, boardId int64, sprintId int64) (*SprintReport, error) {
+	apiEndpoint := fmt.Sprintf(
+		"/rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=%d&sprintId=%d",
+		boardId,
+		sprintId,
+	)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	report := new(SprintReport)
+	_, err = doRequest(ctx, client, req, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+//// Custom Structs
+
+type SprintReport struct {
+	Contents SprintReportContents `json:"contents"`
+	Sprint   SprintReportSprint   `json:"sprint"`
+}
+
+type SprintReportContents struct {
+	CompletedIssues                   []interface{}        `json:"completedIssues"`
+	IssuesNotCompletedInCurrentSprint []interface{}        `json:"issuesNotCompletedInCurrentSprint"`
+	CompletedIssuesEstimateSum        SprintReportEstimate `json:"completedIssuesEstimateSum"`
+	IssuesNotCompletedEstimateSum     SprintReportEstimate `json:"issuesNotCompletedEstimateSum"`
+	AllIssuesEstimateSum              SprintReportEstimate `json:"allIssuesEstimateSum"`
+}
+
+type SprintReportEstimate struct {
+	Value *float64 `json:"value"`
+	Text  string   `json:"text"`
+}
+
+type SprintReportSprint struct {
+	Id    int64  `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+type SprintReportInfo struct {
+	BoardId  int64
+	SprintId int64
+	Report   SprintReport
+}
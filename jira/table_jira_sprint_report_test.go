@@ -0,0 +1,37 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIsSprintReportAvailable(t *testing.T) {
+	tests := []struct {
+		deploymentType string
+		expected       bool
+	}{
+		{"Cloud", true},
+		{"Server", true},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("deployment type %q", test.deploymentType), func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rest/api/2/serverInfo" {
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+				fmt.Fprintf(w, `{"deploymentType": %q}`, test.deploymentType)
+			})
+
+			available, err := isSprintReportAvailable(newTestContext(), newTestQueryData(client, jiraConfig{}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if available != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, available)
+			}
+		})
+	}
+}
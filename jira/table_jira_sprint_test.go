@@ -0,0 +1,52 @@
+package jira
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSprintMatchesDateQuals(t *testing.T) {
+	var listResult ListSprintResult
+	err := json.Unmarshal([]byte(`{"maxResults": 50, "startAt": 0, "isLast": true, "values": [
+		{"id": 1, "name": "Sprint 1", "state": "closed", "startDate": "2024-01-01T09:00:00.000Z", "completeDate": "2024-01-14T17:00:00.000Z"},
+		{"id": 2, "name": "Sprint 2", "state": "closed", "startDate": "2024-01-15T09:00:00.000Z", "completeDate": "2024-01-28T17:00:00.000Z"},
+		{"id": 3, "name": "Sprint 3", "state": "active", "startDate": "2024-01-29T09:00:00.000Z"},
+		{"id": 4, "name": "Sprint 4", "state": "future"}
+	]}`), &listResult)
+	if err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+
+	d := newTestQueryData(nil, jiraConfig{})
+	d.Quals = plugin.KeyColumnQualMap{
+		"start_date": &plugin.KeyColumnQuals{
+			Name: "start_date",
+			Quals: quals.QualSlice{{
+				Column:   "start_date",
+				Operator: ">=",
+				Value:    &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC))}},
+			}},
+		},
+	}
+
+	var names []string
+	for _, sprint := range listResult.Values {
+		if sprintMatchesDateQuals(d, sprint) {
+			names = append(names, sprint.Name)
+		}
+	}
+
+	// The sprint starting on the boundary is kept, the future sprint without
+	// a start date isn't
+	expected := []string{"Sprint 2", "Sprint 3"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
@@ -0,0 +1,168 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableStatusUsage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_status_usage",
+		Description: "The statuses of the Jira site, with the number of issues in each status.",
+		List: &plugin.ListConfig{
+			Hydrate: listStatuses,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "jql", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				// Limit concurrency to avoid a 429 too many requests error
+				Func:           getStatusIssueCount,
+				MaxConcurrency: 10,
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "status_id",
+				Description: "The ID of the status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "status_name",
+				Description: "The name of the status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "status_category",
+				Description: "The name of the category of the status, e.g. To Do, In Progress or Done.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatusCategory.Name"),
+			},
+			{
+				Name:        "issue_count",
+				Description: "The number of issues in the status. This runs an issue search per status, so only select it when needed.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getStatusIssueCount,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "jql",
+				Description: "A JQL query to restrict the issues that are counted. This is AND-combined with the default_jql of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("jql"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStatuses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_usage.listStatuses", "connection_error", err)
+		return nil, err
+	}
+
+	// Paging not supported
+	req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/2/status", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_usage.listStatuses", "get_request_error", err)
+		return nil, err
+	}
+
+	var statuses []Status
+	_, err = doRequest(ctx, client, req, &statuses)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_usage.listStatuses", "api_error", err)
+		return nil, err
+	}
+
+	for _, status := range statuses {
+		d.StreamListItem(ctx, status)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStatusIssueCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	status := h.Item.(Status)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_usage.getStatusIssueCount", "connection_error", err)
+		return nil, err
+	}
+
+	jiraConfig := GetConfig(d.Connection)
+	var defaultJQL string
+	if jiraConfig.DefaultJQL != nil {
+		defaultJQL = *jiraConfig.DefaultJQL
+	}
+	jql, err := combineJQL(defaultJQL, d.KeyColumnQualString("jql"), fmt.Sprintf("status = %s", status.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the total is needed, so don't return any issues
+	params := url.Values{}
+	params.Set("jql", jql)
+	params.Set("maxResults", "0")
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/search?%s", params.Encode())
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_usage.getStatusIssueCount", "get_request_error", err)
+		return nil, err
+	}
+
+	result := new(SearchIssuesResult)
+	_, err = doRequest(ctx, client, req, result)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_usage.getStatusIssueCount", "api_error", err, "status_id", status.ID)
+		return nil, err
+	}
+
+	return result.Total, nil
+}
+
+//// Custom Structs
+
+type Status struct {
+	ID             string         `json:"id"`
+	Self           string         `json:"self"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	StatusCategory StatusCategory `json:"statusCategory"`
+}
+
+type StatusCategory struct {
+	ID   int64  `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
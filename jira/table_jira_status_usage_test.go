@@ -0,0 +1,70 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+func TestGetStatusIssueCount(t *testing.T) {
+	statusesFixture := `[
+		{"id": "1", "name": "Open", "statusCategory": {"id": 2, "key": "new", "name": "To Do"}},
+		{"id": "10001", "name": "Done", "statusCategory": {"id": 3, "key": "done", "name": "Done"}},
+		{"id": "10002", "name": "Unused", "statusCategory": {"id": 4, "key": "indeterminate", "name": "In Progress"}}
+	]`
+	totals := map[string]string{
+		`(project = ENG) AND (type = Bug) AND (status = 1)`:     `{"startAt": 0, "maxResults": 0, "total": 12, "issues": []}`,
+		`(project = ENG) AND (type = Bug) AND (status = 10001)`: `{"startAt": 0, "maxResults": 0, "total": 30, "issues": []}`,
+		`(project = ENG) AND (type = Bug) AND (status = 10002)`: `{"startAt": 0, "maxResults": 0, "total": 0, "issues": []}`,
+	}
+
+	var mutex sync.Mutex
+	var maxResults []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		maxResults = append(maxResults, r.URL.Query().Get("maxResults"))
+		mutex.Unlock()
+
+		total, ok := totals[r.URL.Query().Get("jql")]
+		if !ok {
+			t.Errorf("unexpected JQL %q", r.URL.Query().Get("jql"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, total)
+	})
+
+	defaultJQL := "project = ENG"
+	d := newTestQueryData(client, jiraConfig{DefaultJQL: &defaultJQL})
+	d.KeyColumnQuals = map[string]*proto.QualValue{
+		"jql": {Value: &proto.QualValue_StringValue{StringValue: "type = Bug"}},
+	}
+
+	var statuses []Status
+	if err := json.Unmarshal([]byte(statusesFixture), &statuses); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+
+	expected := map[string]int{"Open": 12, "Done": 30, "Unused": 0}
+	for _, status := range statuses {
+		count, err := getStatusIssueCount(newTestContext(), d, &plugin.HydrateData{Item: status})
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", status.Name, err)
+		}
+		if count != expected[status.Name] {
+			t.Errorf("expected %d issues for %s, got %v", expected[status.Name], status.Name, count)
+		}
+	}
+
+	// Only the totals are requested, not the issues
+	for _, value := range maxResults {
+		if value != "0" {
+			t.Errorf("expected maxResults=0, got %q", value)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableSystemInfo(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_system_info",
+		Description: "Reachability and authentication status of the Jira instance, for connection health checks.",
+		List: &plugin.ListConfig{
+			Hydrate: listSystemInfo,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "reachable",
+				Description: "Whether the Jira instance responded to the request.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "authenticated",
+				Description: "Whether the credentials of the connection were accepted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "base_url",
+				Description: "The base URL of the Jira instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerInfo.BaseUrl"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of Jira.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerInfo.Version"),
+			},
+			{
+				Name:        "deployment_type",
+				Description: "The type of the deployment. Valid values are Cloud, Server and DataCenter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerInfo.DeploymentType"),
+			},
+			{
+				Name:        "build_number",
+				Description: "The build number of Jira.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ServerInfo.BuildNumber"),
+			},
+			{
+				Name:        "server_title",
+				Description: "The name of the Jira instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerInfo.ServerTitle"),
+			},
+			{
+				Name:        "authenticated_account_id",
+				Description: "The account ID of the user the connection is authenticated as.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Myself.AccountID"),
+			},
+			{
+				Name:        "error",
+				Description: "The error returned by Jira, if the instance isn't reachable or the credentials were rejected.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Error").NullIfZero(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSystemInfo(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_system_info.listSystemInfo", "connection_error", err)
+		return nil, err
+	}
+
+	// Failures are reported in the row, so that monitoring can assert on it
+	info := SystemInfo{}
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/2/serverInfo", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_system_info.listSystemInfo", "get_request_error", err)
+		return nil, err
+	}
+
+	serverInfo := new(ServerInfo)
+	res, err := doRequest(ctx, client, req, serverInfo)
+	if err != nil {
+		// No response means the instance couldn't be reached at all
+		info.Reachable = res != nil
+		info.Error = getJiraErrorMessage(res, err)
+		d.StreamListItem(ctx, info)
+		return nil, nil
+	}
+	info.Reachable = true
+	info.ServerInfo = serverInfo
+
+	req, err = client.NewRequestWithContext(ctx, "GET", "/rest/api/2/myself", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_system_info.listSystemInfo", "get_request_error", err)
+		return nil, err
+	}
+
+	myself := new(Myself)
+	res, err = doRequest(ctx, client, req, myself)
+	if err != nil {
+		info.Error = getJiraErrorMessage(res, err)
+		d.StreamListItem(ctx, info)
+		return nil, nil
+	}
+	info.Authenticated = true
+	info.Myself = myself
+
+	d.StreamListItem(ctx, info)
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type SystemInfo struct {
+	Reachable     bool
+	Authenticated bool
+	ServerInfo    *ServerInfo
+	Myself        *Myself
+	Error         string
+}
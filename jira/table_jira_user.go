@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
@@ -26,6 +27,10 @@ func tableUser(_ context.Context) *plugin.Table {
 				Func:           getUserGroups,
 				MaxConcurrency: 50,
 			},
+			{
+				Func:           getUserGroupCount,
+				MaxConcurrency: 50,
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -72,6 +77,13 @@ func tableUser(_ context.Context) *plugin.Table {
 				Hydrate:     getUserGroups,
 				Transform:   transform.From(groupNames),
 			},
+			{
+				Name:        "group_count",
+				Description: "The number of groups that the user belongs to.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getUserGroupCount,
+				Transform:   transform.From(groupCount),
+			},
 
 			// Standard columns
 			{
@@ -95,36 +107,60 @@ func listUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
+	maxResults := getPageSize(d, 1000)
+
+	// Jira Server doesn't have the users/search endpoint, so search for all
+	// users by username instead
+	isCloud, err := isCloudDeployment(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user.listUsers", "server_info_error", err)
+		return nil, err
+	}
+
+	jiraConfig := GetConfig(d.Connection)
+	err = pageUsers(ctx, client, isCloud, maxResults, jiraConfig.IgnoreErrorCodes, func(user jira.User) bool {
+		d.StreamListItem(ctx, user)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.QueryStatus.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	return nil, nil
+}
+
+// pageUsers:: calls stream with each user, until it returns false. If a
+// page after the first fails with one of ignoreErrorCodes, paging stops
+// without an error, keeping the users already streamed.
+func pageUsers(ctx context.Context, client *jira.Client, isCloud bool, maxResults int, ignoreErrorCodes []int, stream func(jira.User) bool) error {
 	last := 0
 	for {
 		apiEndpoint := fmt.Sprintf("rest/api/2/users/search?startAt=%d&maxResults=%d", last, maxResults)
+		if !isCloud {
+			apiEndpoint = fmt.Sprintf("rest/api/2/user/search?username=.&includeInactive=true&startAt=%d&maxResults=%d", last, maxResults)
+		}
 
-		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 		if err != nil {
 			plugin.Logger(ctx).Error("jira_user.listUsers", "get_request_error", err)
-			return nil, err
+			return err
 		}
 
 		users := new([]jira.User)
-		_, err = client.Do(req, users)
+		_, err = doRequest(ctx, client, req, users)
 		if err != nil {
+			if last > 0 && hasAnyStatusCode(err, ignoreErrorCodes) {
+				plugin.Logger(ctx).Warn("jira_user.listUsers", "paging_error", err, "start_at", last)
+				return nil
+			}
 			plugin.Logger(ctx).Error("jira_user.listUsers", "api_error", err)
-			return nil, err
+			return err
 		}
 
 		for _, user := range *users {
-			d.StreamListItem(ctx, user)
-			// Context may get cancelled due to manual cancellation or if the limit has been reached
-			if d.QueryStatus.RowsRemaining(ctx) == 0 {
-				return nil, nil
+			if !stream(user) {
+				return nil
 			}
 		}
 
@@ -133,8 +169,8 @@ func listUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 
 		// API doesn't gives paging parameters in the response,
 		// therefore using output length to quit paging
-		if len(*users) < 1000 {
-			return nil, nil
+		if len(*users) < maxResults {
+			return nil
 		}
 	}
 }
@@ -142,6 +178,11 @@ func listUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 //// HYDRATE FUNCTIONS
 
 func getUserGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// This makes an API call per user, so skip it unless the groups are selected
+	if !isColumnRequested(d, "group_names") {
+		return nil, nil
+	}
+
 	user := h.Item.(jira.User)
 
 	client, err := connect(ctx, d)
@@ -150,7 +191,7 @@ func getUserGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		return nil, err
 	}
 
-	groups, _, err := client.User.GetGroups(user.AccountID)
+	groups, _, err := client.User.GetGroupsWithContext(ctx, user.AccountID)
 	if err != nil {
 		plugin.Logger(ctx).Error("jira_user.getUserGroups", "api_error", err)
 		return nil, err
@@ -159,9 +200,48 @@ func getUserGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	return groups, nil
 }
 
+// getUserGroupCount:: returns the number of groups of the user, without
+// listing them. If group_names is also selected, the count is taken from
+// the listed groups instead.
+func getUserGroupCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	if !isColumnRequested(d, "group_count") || isColumnRequested(d, "group_names") {
+		return nil, nil
+	}
+
+	user := h.Item.(jira.User)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user.getUserGroupCount", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/user?accountId=%s&expand=groups", url.QueryEscape(user.AccountID))
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user.getUserGroupCount", "get_request_error", err)
+		return nil, err
+	}
+
+	userGroups := new(UserWithGroups)
+	_, err = doRequest(ctx, client, req, userGroups)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_user.getUserGroupCount", "api_error", err)
+		return nil, err
+	}
+
+	return userGroups.Groups.Size, nil
+}
+
 //// TRANSFORM FUNCTION
 
 func groupNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
 	userGroups := d.HydrateItem.(*[]jira.UserGroup)
 	var groupNames []string
 	for _, group := range *userGroups {
@@ -169,3 +249,23 @@ func groupNames(_ context.Context, d *transform.TransformData) (interface{}, err
 	}
 	return groupNames, nil
 }
+
+func groupCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if userGroups, ok := d.HydrateResults["getUserGroups"].(*[]jira.UserGroup); ok && userGroups != nil {
+		return len(*userGroups), nil
+	}
+	// getUserGroupCount returns nothing if the user can't be found
+	if count, ok := d.HydrateItem.(int); ok {
+		return count, nil
+	}
+	return nil, nil
+}
+
+//// Custom Structs
+
+type UserWithGroups struct {
+	AccountID string `json:"accountId"`
+	Groups    struct {
+		Size int `json:"size"`
+	} `json:"groups"`
+}
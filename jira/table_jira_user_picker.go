@@ -0,0 +1,123 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableUserPicker(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_user_picker",
+		Description: "Users whose display name or email address match a search query, as suggested by the user picker.",
+		List: &plugin.ListConfig{
+			Hydrate:    listUserPicker,
+			KeyColumns: plugin.SingleColumn("query"),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "account_id",
+				Description: "The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the user. Depending on the user's privacy setting, this may be returned as null.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "html",
+				Description: "The display name, email address, and key of the user with the matched query string highlighted with the HTML bold tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the user. Only returned by Jira Data Center and Server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key").NullIfZero(),
+			},
+			{
+				Name:        "query",
+				Description: "The string to find in the display names and email addresses of the users.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listUserPicker(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	query := d.KeyColumnQualString("query")
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user_picker.listUserPicker", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	maxResults := getPageSize(d, 1000)
+
+	// The picker isn't paged, it returns at most maxResults matches
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user/picker?query=%s&maxResults=%d", url.QueryEscape(query), maxResults)
+
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user_picker.listUserPicker", "get_request_error", err)
+		return nil, err
+	}
+
+	pickerResult := new(UserPickerResult)
+	_, err = doRequest(ctx, client, req, pickerResult)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user_picker.listUserPicker", "api_error", err)
+		return nil, err
+	}
+
+	for _, user := range pickerResult.Users {
+		d.StreamListItem(ctx, user)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type UserPickerResult struct {
+	Header string       `json:"header"`
+	Total  int          `json:"total"`
+	Users  []UserPicker `json:"users"`
+}
+
+type UserPicker struct {
+	AccountId   string `json:"accountId"`
+	AccountType string `json:"accountType"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Html        string `json:"html"`
+	DisplayName string `json:"displayName"`
+	AvatarUrl   string `json:"avatarUrl"`
+}
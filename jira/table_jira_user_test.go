@@ -0,0 +1,154 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func TestPageUsersFailingSecondPage(t *testing.T) {
+	newClient := func(t *testing.T) *jira.Client {
+		return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("startAt") != "0" {
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprint(w, `{"errorMessages": ["Internal server error"]}`)
+				return
+			}
+			fmt.Fprint(w, `[{"accountId": "1"}, {"accountId": "2"}]`)
+		})
+	}
+
+	tests := []struct {
+		name             string
+		ignoreErrorCodes []int
+		expectError      bool
+	}{
+		{"error by default", nil, true},
+		{"other codes ignored", []int{429}, true},
+		{"code ignored", []int{500}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var accountIds []string
+			err := pageUsers(newTestContext(), newClient(t), true, 2, test.ignoreErrorCodes, func(user jira.User) bool {
+				accountIds = append(accountIds, user.AccountID)
+				return true
+			})
+
+			if test.expectError != (err != nil) {
+				t.Errorf("expected error %v, got %v", test.expectError, err)
+			}
+			if len(accountIds) != 2 {
+				t.Errorf("expected the 2 users of the first page, got %v", accountIds)
+			}
+		})
+	}
+}
+
+func TestPageUsersFailingFirstPage(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	err := pageUsers(newTestContext(), client, true, 2, []int{500}, func(user jira.User) bool {
+		return true
+	})
+	if err == nil {
+		t.Error("expected an error for the first page")
+	}
+}
+
+func TestUserGroupHydrates(t *testing.T) {
+	tests := []struct {
+		name          string
+		columns       []string
+		expectedCalls map[string]int
+	}{
+		{"groups not selected", []string{"account_id", "display_name"}, map[string]int{}},
+		{"count only", []string{"account_id", "group_count"}, map[string]int{"/rest/api/2/user": 1}},
+		{"names", []string{"account_id", "group_names"}, map[string]int{"/rest/api/2/user/groups": 1}},
+		{"names and count", []string{"group_names", "group_count"}, map[string]int{"/rest/api/2/user/groups": 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls := map[string]int{}
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls[r.URL.Path]++
+				switch r.URL.Path {
+				case "/rest/api/2/user/groups":
+					fmt.Fprint(w, `[{"name": "jira-users"}, {"name": "site-admins"}]`)
+				case "/rest/api/2/user":
+					fmt.Fprint(w, `{"accountId": "1", "groups": {"size": 2, "items": []}}`)
+				default:
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+			})
+			d := newTestQueryData(client, jiraConfig{})
+			d.QueryContext.Columns = test.columns
+			h := &plugin.HydrateData{Item: jira.User{AccountID: "1"}}
+
+			groups, err := getUserGroups(newTestContext(), d, h)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			count, err := getUserGroupCount(newTestContext(), d, h)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(calls, test.expectedCalls) {
+				t.Errorf("expected calls %v, got %v", test.expectedCalls, calls)
+			}
+
+			// The count is taken from whichever hydrate made a call
+			actual, err := groupCount(context.Background(), &transform.TransformData{
+				HydrateItem:    count,
+				HydrateResults: map[string]interface{}{"getUserGroups": groups},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(test.expectedCalls) > 0 && actual != 2 {
+				t.Errorf("expected a group count of 2, got %v", actual)
+			}
+		})
+	}
+}
+
+func TestGetUserGroupCount(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		// The size is reported without the groups themselves
+		fmt.Fprint(w, `{"accountId": "1", "groups": {"size": 42, "items": []}}`)
+	})
+	d := newTestQueryData(client, jiraConfig{})
+	d.QueryContext.Columns = []string{"account_id", "group_count"}
+	h := &plugin.HydrateData{Item: jira.User{AccountID: "1"}}
+
+	groups, err := getUserGroups(newTestContext(), d, h)
+	if err != nil || groups != nil {
+		t.Errorf("expected no group names, got %v (error %v)", groups, err)
+	}
+	count, err := getUserGroupCount(newTestContext(), d, h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedRequests := []string{"/rest/api/2/user?accountId=1&expand=groups"}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("expected requests %v, got %v", expectedRequests, requests)
+	}
+	actual, _ := groupCount(context.Background(), &transform.TransformData{HydrateItem: count, HydrateResults: map[string]interface{}{}})
+	if actual != 42 {
+		t.Errorf("expected a group count of 42, got %v", actual)
+	}
+}
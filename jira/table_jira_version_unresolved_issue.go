@@ -0,0 +1,180 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableVersionUnresolvedIssue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_version_unresolved_issue",
+		Description: "The unresolved issues to be fixed in a version.",
+		List: &plugin.ListConfig{
+			Hydrate: listVersionUnresolvedIssues,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "version_id", Require: plugin.Required},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "version_id",
+				Description: "The ID of the version the issues are to be fixed in.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the issue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.ID"),
+			},
+			{
+				Name:        "key",
+				Description: "The key of the issue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Key"),
+			},
+			{
+				Name:        "project_key",
+				Description: "A friendly key that identifies the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Fields.Project.Key"),
+			},
+			{
+				Name:        "summary",
+				Description: "The summary of the issue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Fields.Summary"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the issue. Eg: To Do, In Progress.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Fields.Status.Name"),
+			},
+			{
+				Name:        "type",
+				Description: "The name of the issue type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Fields.Type.Name"),
+			},
+			{
+				Name:        "priority",
+				Description: "Priority assigned to the issue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Fields.Priority.Name"),
+			},
+			{
+				Name:        "assignee_account_id",
+				Description: "Account Id the user/application that the issue is assigned to work.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Fields.Assignee.AccountID"),
+			},
+			{
+				Name:        "assignee_display_name",
+				Description: "Display name the user/application that the issue is assigned to work.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Fields.Assignee.DisplayName"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issue.Key"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVersionUnresolvedIssues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	versionId := d.KeyColumnQuals["version_id"].GetInt64Value()
+	if versionId == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_version_unresolved_issue.listVersionUnresolvedIssues", "connection_error", err)
+		return nil, err
+	}
+
+	jql, err := getVersionUnresolvedIssuesJQL(d, versionId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = searchVersionUnresolvedIssues(ctx, client, jql, getPageSize(d, 100), func(issue jira.Issue) bool {
+		d.StreamListItem(ctx, VersionIssueInfo{versionId, issue})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.QueryStatus.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		// The search fails if the version doesn't exist
+		if isBadRequestError(err) || isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_version_unresolved_issue.listVersionUnresolvedIssues", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// getVersionUnresolvedIssuesJQL:: returns the JQL for the unresolved issues of
+// the version, AND-combined with the default_jql of the connection
+func getVersionUnresolvedIssuesJQL(d *plugin.QueryData, versionId int64) (string, error) {
+	jiraConfig := GetConfig(d.Connection)
+	var defaultJQL string
+	if jiraConfig.DefaultJQL != nil {
+		defaultJQL = *jiraConfig.DefaultJQL
+	}
+	return combineJQL(defaultJQL, fmt.Sprintf("fixVersion = %d AND resolution = Unresolved", versionId))
+}
+
+// searchVersionUnresolvedIssues:: pages through the issues matching the JQL,
+// calling stream with each one until it returns false
+func searchVersionUnresolvedIssues(ctx context.Context, client *jira.Client, jql string, maxResults int, stream func(jira.Issue) bool) error {
+	// Only the fields needed for the columns are returned
+	options := jira.SearchOptions{
+		StartAt:    0,
+		MaxResults: maxResults,
+		Fields:     []string{"summary", "status", "issuetype", "priority", "assignee", "project"},
+	}
+
+	for {
+		issues, resp, err := client.Issue.SearchWithContext(ctx, jql, &options)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range issues {
+			if !stream(issue) {
+				return nil
+			}
+		}
+
+		last := resp.StartAt + len(issues)
+		if last >= resp.Total || len(issues) == 0 {
+			return nil
+		}
+		options.StartAt = last
+	}
+}
+
+//// Custom Structs
+
+type VersionIssueInfo struct {
+	VersionId int64
+	Issue     jira.Issue
+}
@@ -0,0 +1,65 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestSearchVersionUnresolvedIssues(t *testing.T) {
+	pages := map[string]string{
+		"0": `{"startAt": 0, "maxResults": 2, "total": 3, "issues": [
+			{"id": "1", "key": "ENG-1", "fields": {"summary": "Fix login", "status": {"name": "To Do"}}},
+			{"id": "2", "key": "ENG-2", "fields": {"summary": "Update docs", "status": {"name": "In Progress"}}}
+		]}`,
+		"2": `{"startAt": 2, "maxResults": 2, "total": 3, "issues": [
+			{"id": "3", "key": "ENG-3", "fields": {"summary": "Bump version", "status": {"name": "In Review"}}}
+		]}`,
+	}
+
+	defaultJQL := "project = ENG"
+	d := newTestQueryData(nil, jiraConfig{DefaultJQL: &defaultJQL})
+	jql, err := getVersionUnresolvedIssuesJQL(d, 10001)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedJQL := "(project = ENG) AND (fixVersion = 10001 AND resolution = Unresolved)"
+	if jql != expectedJQL {
+		t.Errorf("expected JQL %q, got %q", expectedJQL, jql)
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("jql") != expectedJQL {
+			t.Errorf("unexpected JQL %q", r.URL.Query().Get("jql"))
+		}
+		// go-jira leaves out a zero startAt
+		startAt := r.URL.Query().Get("startAt")
+		if startAt == "" {
+			startAt = "0"
+		}
+		page, ok := pages[startAt]
+		if !ok {
+			t.Errorf("unexpected startAt %q", startAt)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, page)
+	})
+
+	var keys []string
+	err = searchVersionUnresolvedIssues(newTestContext(), client, jql, 2, func(issue jira.Issue) bool {
+		keys = append(keys, fmt.Sprintf("%s %s", issue.Key, issue.Fields.Status.Name))
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"ENG-1 To Do", "ENG-2 In Progress", "ENG-3 In Review"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v, got %v", expected, keys)
+	}
+}
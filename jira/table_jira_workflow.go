@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/andygrunwald/go-jira"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
 
@@ -80,89 +81,109 @@ func listWorkflows(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 		return nil, err
 	}
 
+	err = forEachWorkflow(ctx, d, client, func(workflow Workflow) bool {
+		d.StreamListItem(ctx, workflow)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.QueryStatus.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow.listWorkflows", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWorkflow(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workflowName := d.KeyColumnQuals["name"].GetStringValue()
+
+	if workflowName == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow.getWorkflow", "connection_error", err)
+		return nil, err
+	}
+
+	workflow, err := getWorkflowByName(ctx, client, workflowName)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow.getWorkflow", "api_error", err)
+		return nil, err
+	}
+	if workflow == nil {
+		return nil, nil
+	}
+
+	return *workflow, nil
+}
+
+//// UTILITY FUNCTIONS
+
+const workflowExpand = "transitions,transitions.rules,statuses,statuses.properties,default"
+
+// forEachWorkflow pages through all workflows, calling fn for each one until
+// it returns false
+func forEachWorkflow(ctx context.Context, d *plugin.QueryData, client *jira.Client, fn func(Workflow) bool) error {
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
-	queryLimit := d.QueryContext.Limit
-	var maxResults int = 1000
-	if d.QueryContext.Limit != nil {
-		if *queryLimit < 1000 {
-			maxResults = int(*queryLimit)
-		}
-	}
+	maxResults := getPageSize(d, 1000)
 
 	last := 0
 	for {
-		apiEndpoint := fmt.Sprintf(
-			"/rest/api/3/workflow/search?startAt=%d&maxResults=%d&expand=transitions,transitions.rules,statuses,statuses.properties,default",
-			last,
-			maxResults,
-		)
+		apiEndpoint := fmt.Sprintf("/rest/api/3/workflow/search?startAt=%d&maxResults=%d&expand=%s", last, maxResults, workflowExpand)
 
-		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 		if err != nil {
-			plugin.Logger(ctx).Error("jira_workflow.listWorkflows", "get_request_error", err)
-			return nil, err
+			return err
 		}
 
 		listResult := new(ListWorkflowResult)
-		_, err = client.Do(req, listResult)
+		_, err = doRequest(ctx, client, req, listResult)
 		if err != nil {
-			plugin.Logger(ctx).Error("jira_workflow.listWorkflows", "api_error", err)
-			return nil, err
+			return err
 		}
 
 		for _, workflow := range listResult.Values {
-			d.StreamListItem(ctx, workflow)
-			// Context may get cancelled due to manual cancellation or if the limit has been reached
-			if d.QueryStatus.RowsRemaining(ctx) == 0 {
-				return nil, nil
+			if !fn(workflow) {
+				return nil
 			}
 		}
 
 		last = listResult.StartAt + len(listResult.Values)
-		if listResult.IsLast {
-			return nil, nil
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil
 		}
 	}
 }
 
-//// HYDRATE FUNCTIONS
-
-func getWorkflow(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	workflowName := url.PathEscape(d.KeyColumnQuals["name"].GetStringValue())
-
-	if workflowName == "" {
-		return nil, nil
-	}
+// getWorkflowByName looks up a single workflow by its name, returning nil if
+// there is no such workflow
+func getWorkflowByName(ctx context.Context, client *jira.Client, name string) (*Workflow, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/workflow/search?workflowName=%s&expand=%s", url.QueryEscape(name), workflowExpand)
 
-	client, err := connect(ctx, d)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
-		plugin.Logger(ctx).Error("jira_workflow.getWorkflow", "connection_error", err)
 		return nil, err
 	}
 
-	apiEndpoint := fmt.Sprintf(
-		"/rest/api/3/workflow/search?workflowName=%s&expand=transitions,transitions.rules,statuses,statuses.properties,default",
-		workflowName,
-	)
-
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	listResult := new(ListWorkflowResult)
+	_, err = doRequest(ctx, client, req, listResult)
 	if err != nil {
-		plugin.Logger(ctx).Error("jira_workflow.getWorkflow", "get_request_error", err)
 		return nil, err
 	}
 
-	workflow := new(ListWorkflowResult)
-	_, err = client.Do(req, workflow)
-	if err != nil {
-		plugin.Logger(ctx).Error("jira_workflow.getWorkflow", "api_error", err)
-		return nil, err
-	}
-	if len(workflow.Values) < 1 {
-		return nil, nil
+	// The search matches the name exactly, but guard against anything else
+	for _, workflow := range listResult.Values {
+		if workflow.ID.Name == name {
+			return &workflow, nil
+		}
 	}
 
-	return workflow.Values[0], nil
+	return nil, nil
 }
 
 //// Custom Structs
@@ -0,0 +1,33 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetWorkflowByName(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("workflowName") != "Software Simplified Workflow" {
+			fmt.Fprint(w, `{"isLast": true, "values": []}`)
+			return
+		}
+		fmt.Fprint(w, `{"isLast": true, "values": [{"id": {"name": "Software Simplified Workflow"}, "transitions": [{"id": "11", "name": "To Do"}]}]}`)
+	})
+
+	workflow, err := getWorkflowByName(newTestContext(), client, "Software Simplified Workflow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workflow == nil || len(workflow.Transitions) != 1 {
+		t.Fatalf("expected the workflow with 1 transition, got %+v", workflow)
+	}
+
+	workflow, err = getWorkflowByName(newTestContext(), client, "Missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workflow != nil {
+		t.Errorf("expected no workflow, got %+v", workflow)
+	}
+}
@@ -0,0 +1,144 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWorkflowTransition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_workflow_transition",
+		Description: "A transition is a link between two statuses that enables an issue to move from one status to another.",
+		List: &plugin.ListConfig{
+			Hydrate: listWorkflowTransitions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "workflow_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "workflow_name",
+				Description: "The name of the workflow the transition belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the transition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transition.ID"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the transition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transition.Name"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the transition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transition.Description"),
+			},
+			{
+				Name:        "to",
+				Description: "The ID of the status the transition moves the issue to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transition.To"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the transition. Valid values are global, initial and directed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transition.Type"),
+			},
+			{
+				Name:        "screen_id",
+				Description: "The ID of the screen shown for the transition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transition.Screen.ID").NullIfZero(),
+			},
+
+			// json fields
+			{
+				Name:        "from",
+				Description: "The IDs of the statuses the transition can be made from. Empty for global and initial transitions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Transition.From"),
+			},
+			{
+				Name:        "rules",
+				Description: "The conditions, validators and post functions of the transition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Transition.Rules"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transition.Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowTransitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow_transition.listWorkflowTransitions", "connection_error", err)
+		return nil, err
+	}
+
+	// Only look up the requested workflow, if one is given
+	workflowName := d.KeyColumnQualString("workflow_name")
+	if workflowName != "" {
+		workflow, err := getWorkflowByName(ctx, client, workflowName)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_workflow_transition.listWorkflowTransitions", "api_error", err)
+			return nil, err
+		}
+		if workflow != nil {
+			streamWorkflowTransitions(ctx, d, *workflow)
+		}
+		return nil, nil
+	}
+
+	err = forEachWorkflow(ctx, d, client, func(workflow Workflow) bool {
+		return streamWorkflowTransitions(ctx, d, workflow)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow_transition.listWorkflowTransitions", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamWorkflowTransitions streams the transitions of a single workflow and
+// reports whether more rows are wanted
+func streamWorkflowTransitions(ctx context.Context, d *plugin.QueryData, workflow Workflow) bool {
+	for _, transition := range workflow.Transitions {
+		d.StreamListItem(ctx, WorkflowTransitionInfo{workflow.ID.Name, transition})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+//// Custom Structs
+
+type WorkflowTransitionInfo struct {
+	WorkflowName string
+	Transition   WorkflowTransition
+}
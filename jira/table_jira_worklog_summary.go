@@ -0,0 +1,235 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWorklogSummary(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_worklog_summary",
+		Description: "The time logged on issues, totalled per author for each issue.",
+		List: &plugin.ListConfig{
+			Hydrate: listWorklogSummaries,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "jql", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue the time was logged on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "author_account_id",
+				Description: "Account Id of the user who logged the time.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "author_display_name",
+				Description: "Display name of the user who logged the time.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_time_spent_seconds",
+				Description: "The total time logged by the author on the issue, in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "worklog_count",
+				Description: "The number of worklogs of the author on the issue.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "jql",
+				Description: "A JQL query to filter the issues with. This is AND-combined with the default_jql of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("jql"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IssueKey"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorklogSummaries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_worklog_summary.listWorklogSummaries", "connection_error", err)
+		return nil, err
+	}
+
+	jiraConfig := GetConfig(d.Connection)
+	var defaultJQL string
+	if jiraConfig.DefaultJQL != nil {
+		defaultJQL = *jiraConfig.DefaultJQL
+	}
+	jql, err := combineJQL(defaultJQL, d.KeyColumnQualString("jql"))
+	if err != nil {
+		return nil, err
+	}
+
+	last := 0
+	maxResults := getPageSize(d, 100)
+	for {
+		params := url.Values{}
+		params.Set("jql", jql)
+		params.Set("startAt", strconv.Itoa(last))
+		params.Set("maxResults", strconv.Itoa(maxResults))
+		params.Set("fields", "worklog")
+
+		// The search returns the first page of worklogs of each issue, so the
+		// worklog endpoint is only called for issues with more worklogs
+		apiEndpoint := fmt.Sprintf("/rest/api/2/search?%s", params.Encode())
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_worklog_summary.listWorklogSummaries", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(WorklogSearchResult)
+		_, err = doRequest(ctx, client, req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_worklog_summary.listWorklogSummaries", "api_error", err)
+			return nil, err
+		}
+
+		for _, issue := range listResult.Issues {
+			worklogs := issue.Fields.Worklog.Worklogs
+			if len(worklogs) < issue.Fields.Worklog.Total {
+				worklogs, err = listIssueWorklogs(ctx, client, issue.Key)
+				if err != nil {
+					plugin.Logger(ctx).Error("jira_worklog_summary.listWorklogSummaries", "api_error", err, "issue_key", issue.Key)
+					return nil, err
+				}
+			}
+
+			for _, summary := range summarizeWorklogs(issue.Key, worklogs) {
+				d.StreamListItem(ctx, summary)
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Issues)
+		if last >= listResult.Total {
+			return nil, nil
+		}
+	}
+}
+
+//// UTILITY FUNCTIONS
+
+// listIssueWorklogs:: returns all the worklogs of an issue. The issues are
+// processed one at a time, which caps the requests to one per issue.
+func listIssueWorklogs(ctx context.Context, client *jira.Client, issueKey string) ([]jira.WorklogRecord, error) {
+	var worklogs []jira.WorklogRecord
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/worklog?startAt=%d&maxResults=%d", url.PathEscape(issueKey), last, 5000)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		page := new(WorklogPage)
+		_, err = doRequest(ctx, client, req, page)
+		if err != nil {
+			if isNotFoundError(err) {
+				return worklogs, nil
+			}
+			return nil, err
+		}
+
+		worklogs = append(worklogs, page.Worklogs...)
+
+		last = page.StartAt + len(page.Worklogs)
+		if len(page.Worklogs) == 0 || last >= page.Total {
+			return worklogs, nil
+		}
+	}
+}
+
+// summarizeWorklogs:: totals the worklogs of an issue per author, keeping the
+// authors in the order of their first worklog
+func summarizeWorklogs(issueKey string, worklogs []jira.WorklogRecord) []*WorklogSummary {
+	var summaries []*WorklogSummary
+	byAuthor := map[string]*WorklogSummary{}
+
+	for _, worklog := range worklogs {
+		var accountId, displayName string
+		if worklog.Author != nil {
+			accountId = worklog.Author.AccountID
+			displayName = worklog.Author.DisplayName
+		}
+
+		summary, ok := byAuthor[accountId]
+		if !ok {
+			summary = &WorklogSummary{
+				IssueKey:          issueKey,
+				AuthorAccountId:   accountId,
+				AuthorDisplayName: displayName,
+			}
+			byAuthor[accountId] = summary
+			summaries = append(summaries, summary)
+		}
+		summary.TotalTimeSpentSeconds += int64(worklog.TimeSpentSeconds)
+		summary.WorklogCount++
+	}
+
+	return summaries
+}
+
+//// Custom Structs
+
+type WorklogSearchResult struct {
+	StartAt    int `json:"startAt"`
+	MaxResults int `json:"maxResults"`
+	Total      int `json:"total"`
+	Issues     []struct {
+		ID     string `json:"id"`
+		Key    string `json:"key"`
+		Fields struct {
+			Worklog WorklogPage `json:"worklog"`
+		} `json:"fields"`
+	} `json:"issues"`
+}
+
+type WorklogPage struct {
+	StartAt    int                  `json:"startAt"`
+	MaxResults int                  `json:"maxResults"`
+	Total      int                  `json:"total"`
+	Worklogs   []jira.WorklogRecord `json:"worklogs"`
+}
+
+type WorklogSummary struct {
+	IssueKey              string
+	AuthorAccountId       string
+	AuthorDisplayName     string
+	TotalTimeSpentSeconds int64
+	WorklogCount          int64
+}
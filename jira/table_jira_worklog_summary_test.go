@@ -0,0 +1,49 @@
+package jira
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSummarizeWorklogs(t *testing.T) {
+	fixture := `{
+		"startAt": 0,
+		"maxResults": 20,
+		"total": 5,
+		"worklogs": [
+			{"id": "1", "author": {"accountId": "alice", "displayName": "Alice"}, "timeSpentSeconds": 3600},
+			{"id": "2", "author": {"accountId": "bob", "displayName": "Bob"}, "timeSpentSeconds": 1800},
+			{"id": "3", "author": {"accountId": "alice", "displayName": "Alice"}, "timeSpentSeconds": 7200},
+			{"id": "4", "timeSpentSeconds": 60},
+			{"id": "5", "author": {"accountId": "bob", "displayName": "Bob"}, "timeSpentSeconds": 900}
+		]
+	}`
+
+	var page WorklogPage
+	if err := json.Unmarshal([]byte(fixture), &page); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+
+	actual := summarizeWorklogs("TEST-1", page.Worklogs)
+
+	// Authors are kept in the order of their first worklog, and worklogs
+	// without an author are grouped together
+	expected := []*WorklogSummary{
+		{IssueKey: "TEST-1", AuthorAccountId: "alice", AuthorDisplayName: "Alice", TotalTimeSpentSeconds: 10800, WorklogCount: 2},
+		{IssueKey: "TEST-1", AuthorAccountId: "bob", AuthorDisplayName: "Bob", TotalTimeSpentSeconds: 2700, WorklogCount: 2},
+		{IssueKey: "TEST-1", TotalTimeSpentSeconds: 60, WorklogCount: 1},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		for _, summary := range actual {
+			t.Logf("got %+v", *summary)
+		}
+		t.Errorf("unexpected summaries")
+	}
+}
+
+func TestSummarizeWorklogsEmpty(t *testing.T) {
+	if summaries := summarizeWorklogs("TEST-1", nil); len(summaries) != 0 {
+		t.Errorf("expected no summaries, got %d", len(summaries))
+	}
+}
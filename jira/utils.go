@@ -4,6 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,7 +18,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
 )
 
-func connect(_ context.Context, d *plugin.QueryData) (*jira.Client, error) {
+func connect(ctx context.Context, d *plugin.QueryData) (*jira.Client, error) {
 
 	// Load connection from cache, which preserves throttling protection etc
 	cacheKey := "atlassian-jira"
@@ -41,6 +46,9 @@ func connect(_ context.Context, d *plugin.QueryData) (*jira.Client, error) {
 	if baseUrl == "" {
 		return nil, errors.New("'base_url' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
 	}
+	if parsedUrl, err := url.Parse(baseUrl); err != nil || !parsedUrl.IsAbs() || parsedUrl.Host == "" || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") {
+		return nil, fmt.Errorf("'base_url' must be an absolute http or https URL, e.g. https://your-domain.atlassian.net/, got %q. Edit your connection configuration file and then restart Steampipe", baseUrl)
+	}
 	if username == "" {
 		return nil, errors.New("'username' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
 	}
@@ -51,6 +59,12 @@ func connect(_ context.Context, d *plugin.QueryData) (*jira.Client, error) {
 	}
 	tokenProvider.Password = token
 
+	// Log the rate limit headers of each response, to help tune concurrency
+	tokenProvider.Transport = &rateLimitLoggingTransport{
+		transport: http.DefaultTransport,
+		logDebug:  plugin.Logger(ctx).Debug,
+	}
+
 	// Create the client
 	client, err := jira.NewClient(tokenProvider.Client(), baseUrl)
 	if err != nil {
@@ -64,17 +78,411 @@ func connect(_ context.Context, d *plugin.QueryData) (*jira.Client, error) {
 	return client, nil
 }
 
+// rateLimitLoggingTransport logs the rate limit headers returned by Jira at
+// debug level. It doesn't change the requests or responses.
+type rateLimitLoggingTransport struct {
+	transport http.RoundTripper
+	logDebug  func(msg string, args ...interface{})
+}
+
+func (t *rateLimitLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	limit := resp.Header.Get("X-RateLimit-Limit")
+	remaining := resp.Header.Get("X-RateLimit-Remaining")
+	retryAfter := resp.Header.Get("Retry-After")
+	if limit != "" || remaining != "" || retryAfter != "" {
+		t.logDebug("jira.rateLimit", "path", req.URL.Path, "status", resp.StatusCode, "limit", limit, "remaining", remaining, "retry_after", retryAfter)
+	}
+
+	return resp, nil
+}
+
+// getServerInfo:: returns the server info of the Jira instance. The result
+// is cached in the connection cache, since it doesn't change between queries.
+func getServerInfo(ctx context.Context, d *plugin.QueryData) (*ServerInfo, error) {
+	cacheKey := "jira-server-info"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*ServerInfo), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/2/serverInfo", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	serverInfo := new(ServerInfo)
+	_, err = doRequest(ctx, client, req, serverInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, serverInfo)
+
+	return serverInfo, nil
+}
+
+// isCloudDeployment:: checks if the connection is to a Jira Cloud instance
+func isCloudDeployment(ctx context.Context, d *plugin.QueryData) (bool, error) {
+	serverInfo, err := getServerInfo(ctx, d)
+	if err != nil {
+		return false, err
+	}
+	return serverInfo.DeploymentType == "Cloud", nil
+}
+
+type ServerInfo struct {
+	BaseUrl        string `json:"baseUrl"`
+	Version        string `json:"version"`
+	VersionNumbers []int  `json:"versionNumbers"`
+	DeploymentType string `json:"deploymentType"`
+	BuildNumber    int64  `json:"buildNumber"`
+	BuildDate      string `json:"buildDate"`
+	ServerTime     string `json:"serverTime"`
+	ScmInfo        string `json:"scmInfo"`
+	ServerTitle    string `json:"serverTitle"`
+}
+
+// getCacheTTL:: returns how long the results of reference tables should be
+// cached for, as set by cache_ttl_seconds. Zero means caching is disabled.
+func getCacheTTL(d *plugin.QueryData) time.Duration {
+	jiraConfig := GetConfig(d.Connection)
+	if jiraConfig.CacheTTLSeconds == nil || *jiraConfig.CacheTTLSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(*jiraConfig.CacheTTLSeconds) * time.Second
+}
+
+// getWithCacheTTL:: returns the result of fetch, reusing it within the cache
+// TTL. With caching disabled, fetch is called every time.
+func getWithCacheTTL(d *plugin.QueryData, cacheKey string, fetch func() (interface{}, error)) (interface{}, error) {
+	cacheTTL := getCacheTTL(d)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok && cacheTTL > 0 {
+		return cachedData, nil
+	}
+
+	result, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheTTL > 0 {
+		d.ConnectionManager.Cache.SetWithTTL(cacheKey, result, cacheTTL)
+	}
+
+	return result, nil
+}
+
+// queryMemoTTL is how long results memoized for a single query are kept.
+// They are keyed by the query data, so they aren't shared between queries.
+const queryMemoTTL = time.Minute
+
+// getPageSize:: returns the number of items to request per page. It starts
+// from the maximum the endpoint accepts, and is lowered to the page_size
+// config and to the requested number of items, if either is smaller.
+func getPageSize(d *plugin.QueryData, maxPageSize int) int {
+	pageSize := maxPageSize
+
+	jiraConfig := GetConfig(d.Connection)
+	if jiraConfig.PageSize != nil && *jiraConfig.PageSize > 0 && *jiraConfig.PageSize < pageSize {
+		pageSize = *jiraConfig.PageSize
+	}
+
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < int64(pageSize) {
+		pageSize = int(*d.QueryContext.Limit)
+	}
+
+	return pageSize
+}
+
+// getFields:: returns the system and custom fields of the instance, cached
+// for the connection
+func getFields(ctx context.Context, d *plugin.QueryData) ([]jira.Field, error) {
+	cacheKey := "jira-fields"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]jira.Field), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, _, err := client.Field.GetListWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, fields)
+
+	return fields, nil
+}
+
+// getStoryPointField:: returns the ID of the custom field that holds the
+// story points, as set by story_point_field. If it isn't set, look for the
+// field by its default name on first use and cache it for the connection.
+// Returns an empty ID if there is no such field.
+func getStoryPointField(ctx context.Context, d *plugin.QueryData) (string, error) {
+	jiraConfig := GetConfig(d.Connection)
+	if jiraConfig.StoryPointField != nil {
+		return *jiraConfig.StoryPointField, nil
+	}
+
+	cacheKey := "jira-story-point-field"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(string), nil
+	}
+
+	fields, err := getFields(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	// Company-managed projects use "Story Points", team-managed projects use
+	// "Story point estimate"
+	var fieldId string
+	for _, name := range []string{"Story Points", "Story point estimate"} {
+		for _, field := range fields {
+			if field.Custom && strings.EqualFold(field.Name, name) {
+				fieldId = field.ID
+				break
+			}
+		}
+		if fieldId != "" {
+			break
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, fieldId)
+
+	return fieldId, nil
+}
+
+// isVotingDisabled:: returns true if disable_votes is set, for instances
+// where voting is turned off
+func isVotingDisabled(d *plugin.QueryData) bool {
+	jiraConfig := GetConfig(d.Connection)
+	return jiraConfig.DisableVotes != nil && *jiraConfig.DisableVotes
+}
+
+// isWatchingDisabled:: returns true if disable_watches is set, for instances
+// where watching is turned off
+func isWatchingDisabled(d *plugin.QueryData) bool {
+	jiraConfig := GetConfig(d.Connection)
+	return jiraConfig.DisableWatches != nil && *jiraConfig.DisableWatches
+}
+
+// userEmailBatchSize is the number of users looked up per bulk email
+// request, to keep the URL short
+const userEmailBatchSize = 50
+
+// getUserEmails:: returns the email addresses of the given users, looked up
+// with the bulk email endpoint in batches. Addresses are cached per account
+// ID, so each user is only looked up once per connection. The endpoint needs
+// a scope that most credentials don't have, in which case no addresses are
+// returned.
+func getUserEmails(ctx context.Context, d *plugin.QueryData, accountIds []string) (map[string]string, error) {
+	unavailableCacheKey := "jira-user-email-unavailable"
+	if _, ok := d.ConnectionManager.Cache.Get(unavailableCacheKey); ok {
+		return map[string]string{}, nil
+	}
+
+	emails := map[string]string{}
+	var missingAccountIds []string
+	for _, accountId := range accountIds {
+		if _, ok := emails[accountId]; ok {
+			continue
+		}
+		if cachedData, ok := d.ConnectionManager.Cache.Get("jira-user-email-" + accountId); ok {
+			emails[accountId] = cachedData.(string)
+		} else {
+			// Mark it as seen, it's set to the address once it's looked up
+			emails[accountId] = ""
+			missingAccountIds = append(missingAccountIds, accountId)
+		}
+	}
+	if len(missingAccountIds) == 0 {
+		return emails, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	for start := 0; start < len(missingAccountIds); start += userEmailBatchSize {
+		end := start + userEmailBatchSize
+		if end > len(missingAccountIds) {
+			end = len(missingAccountIds)
+		}
+
+		params := url.Values{}
+		for _, accountId := range missingAccountIds[start:end] {
+			params.Add("accountId", accountId)
+		}
+
+		req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/3/user/email/bulk?"+params.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []struct {
+			AccountID string `json:"accountId"`
+			Email     string `json:"email"`
+		}
+		_, err = doRequest(ctx, client, req, &result)
+		if err != nil {
+			if hasStatusCode(err, 401) || isForbiddenError(err) || isNotFoundError(err) {
+				d.ConnectionManager.Cache.Set(unavailableCacheKey, true)
+				return emails, nil
+			}
+			return nil, err
+		}
+
+		for _, accountId := range params["accountId"] {
+			d.ConnectionManager.Cache.Set("jira-user-email-"+accountId, "")
+		}
+		for _, item := range result {
+			emails[item.AccountID] = item.Email
+			d.ConnectionManager.Cache.Set("jira-user-email-"+item.AccountID, item.Email)
+		}
+	}
+
+	return emails, nil
+}
+
 //// Constants
 const (
 	ColumnDescriptionTitle = "Title of the resource."
 )
 
 func isNotFoundError(err error) bool {
-	return strings.Contains(err.Error(), "404")
+	return hasStatusCode(err, 404)
 }
 
 func isBadRequestError(err error) bool {
-	return strings.Contains(err.Error(), "400")
+	return hasStatusCode(err, 400)
+}
+
+func isForbiddenError(err error) bool {
+	return hasStatusCode(err, 403)
+}
+
+// hasStatusCode:: checks the status code of an error returned by doRequest,
+// or looks for the code in the message of errors returned by go-jira
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *jiraAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+	return strings.Contains(err.Error(), strconv.Itoa(statusCode))
+}
+
+// hasAnyStatusCode:: checks if the error is a Jira API error with one of the
+// given status codes
+func hasAnyStatusCode(err error, statusCodes []int) bool {
+	var apiErr *jiraAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, statusCode := range statusCodes {
+		if apiErr.StatusCode == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// doRequest:: sends the request like client.Do, but returns a jiraAPIError
+// with the method, URL, status code and Jira error messages on failure
+func doRequest(ctx context.Context, client *jira.Client, req *http.Request, v interface{}) (*jira.Response, error) {
+	res, err := client.Do(req, v)
+	if err == nil || res == nil {
+		return res, err
+	}
+
+	apiErr := &jiraAPIError{
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		StatusCode: res.StatusCode,
+		Message:    getJiraErrorMessage(res, err),
+		err:        err,
+	}
+	plugin.Logger(ctx).Debug("doRequest", "method", apiErr.Method, "path", apiErr.Path, "status_code", apiErr.StatusCode, "message", apiErr.Message)
+
+	return res, apiErr
+}
+
+// jiraAPIError is an error response from the Jira API
+type jiraAPIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Message    string
+	err        error
+}
+
+func (e *jiraAPIError) Error() string {
+	return fmt.Sprintf("jira api error: %s %s -> %d: %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+func (e *jiraAPIError) Unwrap() error {
+	return e.err
+}
+
+// getJiraErrorMessage:: returns the errorMessages and errors of a failed
+// response as a readable string, falling back to the error itself
+func getJiraErrorMessage(resp *jira.Response, err error) string {
+	// The body has already been read by doRequest
+	var apiErr *jiraAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Message
+	}
+
+	if resp == nil || resp.Body == nil {
+		return err.Error()
+	}
+
+	parsedErr := jira.NewJiraError(resp, err)
+	jiraErr, ok := parsedErr.(*jira.Error)
+	if !ok {
+		// Bodies that aren't JSON, e.g. proxy error pages, are returned with
+		// the status
+		return parsedErr.Error()
+	}
+
+	messages := append([]string{}, jiraErr.ErrorMessages...)
+	keys := make([]string, 0, len(jiraErr.Errors))
+	for key := range jiraErr.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		messages = append(messages, fmt.Sprintf("%s: %s", key, jiraErr.Errors[key]))
+	}
+
+	if len(messages) == 0 {
+		return err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// isColumnRequested:: checks if the given column is selected in the query
+func isColumnRequested(d *plugin.QueryData, columnName string) bool {
+	for _, column := range d.QueryContext.Columns {
+		if column == columnName {
+			return true
+		}
+	}
+	return false
 }
 
 //// TRANSFORM FUNCTION
@@ -95,10 +503,110 @@ func convertJiraDate(_ context.Context, d *transform.TransformData) (interface{}
 	return time.Time(d.Value.(jira.Date)), nil
 }
 
-func buildJQLQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*plugin.Column) string {
+// getJQLTimeLocation:: returns the time zone that JQL dates are interpreted
+// in, which is the time zone in the profile of the authenticated user.
+// Returns nil if it can't be determined.
+func getJQLTimeLocation(ctx context.Context, d *plugin.QueryData) *time.Location {
+	cacheKey := "jira-jql-time-location"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*time.Location)
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Warn("getJQLTimeLocation", "connection_error", err)
+		return nil
+	}
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "/rest/api/2/myself", nil)
+	if err != nil {
+		plugin.Logger(ctx).Warn("getJQLTimeLocation", "get_request_error", err)
+		return nil
+	}
+
+	myself := new(Myself)
+	_, err = doRequest(ctx, client, req, myself)
+	if err != nil {
+		plugin.Logger(ctx).Warn("getJQLTimeLocation", "api_error", err)
+		return nil
+	}
+
+	// time.LoadLocation returns UTC for an empty name
+	if myself.TimeZone == "" {
+		plugin.Logger(ctx).Warn("getJQLTimeLocation", "time_zone", "empty")
+		return nil
+	}
+	location, err := time.LoadLocation(myself.TimeZone)
+	if err != nil {
+		plugin.Logger(ctx).Warn("getJQLTimeLocation", "time_zone", myself.TimeZone, "load_location_error", err)
+		return nil
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, location)
+
+	return location
+}
+
+// hasTimestampQual:: checks if any of the quals is on a timestamp column
+func hasTimestampQual(equalQuals plugin.KeyColumnQualMap, tableColumns []*plugin.Column) bool {
+	for _, column := range tableColumns {
+		if column.Type == proto.ColumnType_TIMESTAMP && equalQuals[column.Name] != nil && len(equalQuals[column.Name].Quals) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// formatJQLTime:: formats the time as a JQL date in the given location
+func formatJQLTime(t time.Time, location *time.Location) string {
+	return t.In(location).Format("2006-01-02 15:04")
+}
+
+// buildJQLTimeClauses:: builds the JQL clauses for a timestamp qual. JQL
+// dates only go down to the minute, so a value with seconds is widened to
+// the whole minute, making sure no matching rows are dropped. Postgres then
+// filters the extra rows out. <> can't be widened, so it isn't pushed down.
+func buildJQLTimeClauses(field string, operator string, t time.Time, location *time.Location) []string {
+	floor := t.Truncate(time.Minute)
+	exact := floor.Equal(t)
+
+	clause := func(operator string, t time.Time) string {
+		return fmt.Sprintf("\"%s\" %s \"%s\"", field, operator, formatJQLTime(t, location))
+	}
+
+	switch operator {
+	case ">", ">=":
+		if exact {
+			return []string{clause(operator, t)}
+		}
+		return []string{clause(">=", floor)}
+	case "<", "<=":
+		if exact {
+			return []string{clause(operator, t)}
+		}
+		return []string{clause("<", floor.Add(time.Minute))}
+	case "=":
+		if exact {
+			return []string{clause("=", t)}
+		}
+		return []string{clause(">=", floor), clause("<", floor.Add(time.Minute))}
+	}
+	return nil
+}
+
+// buildJQLQueryFromQuals:: builds the JQL clauses for the quals of the table.
+// Timestamps are formatted in the given location, since JQL dates don't
+// carry a time zone. If the location is nil, timestamp quals are left for
+// Postgres to filter on rather than guessing the time zone.
+func buildJQLQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*plugin.Column, location *time.Location) string {
 	filters := []string{}
 
 	for _, filterQualItem := range tableColumns {
+		// The raw JQL, paging and validation quals are handled separately
+		if filterQualItem.Name == "jql" || filterQualItem.Name == "start_at" || filterQualItem.Name == "validate_query" {
+			continue
+		}
+
 		filterQual := equalQuals[filterQualItem.Name]
 		if filterQual == nil {
 			continue
@@ -117,17 +625,15 @@ func buildJQLQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*
 					case proto.ColumnType_STRING:
 						switch qual.Operator {
 						case "=":
-							filters = append(filters, fmt.Sprintf("\"%s\" = \"%s\"", getIssueJQLKey(filterQualItem.Name), value.GetStringValue()))
+							filters = append(filters, fmt.Sprintf("\"%s\" = \"%s\"", getIssueJQLKey(filterQualItem.Name), getIssueJQLValue(filterQualItem.Name, value.GetStringValue())))
 						case "<>":
-							filters = append(filters, fmt.Sprintf("%s != \"%s\"", getIssueJQLKey(filterQualItem.Name), value.GetStringValue()))
+							filters = append(filters, fmt.Sprintf("%s != \"%s\"", getIssueJQLKey(filterQualItem.Name), getIssueJQLValue(filterQualItem.Name, value.GetStringValue())))
 						}
 					case proto.ColumnType_TIMESTAMP:
-						switch qual.Operator {
-						case "=", ">=", ">", "<=", "<":
-							filters = append(filters, fmt.Sprintf("\"%s\" %s \"%s\"", getIssueJQLKey(filterQualItem.Name), qual.Operator, value.GetTimestampValue().AsTime().Format("2006-01-02 15:04")))
-						case "<>":
-							filters = append(filters, fmt.Sprintf("\"%s\" != \"%s\"", getIssueJQLKey(filterQualItem.Name), value.GetTimestampValue().AsTime().Format("2006-01-02 15:04")))
+						if location == nil {
+							continue
 						}
+						filters = append(filters, buildJQLTimeClauses(getIssueJQLKey(filterQualItem.Name), qual.Operator, value.GetTimestampValue().AsTime(), location)...)
 
 					}
 				}
@@ -143,6 +649,89 @@ func buildJQLQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*
 	return ""
 }
 
+// combineJQL joins the non-empty JQL clauses with AND, wrapping each one in
+// parentheses. A trailing ORDER BY in one of the clauses is moved to the
+// end. An ORDER BY in more than one clause is an error, as only one can apply.
+func combineJQL(clauses ...string) (string, error) {
+	filters := []string{}
+	orderBy := ""
+
+	for _, clause := range clauses {
+		filter, clauseOrderBy := splitJQLOrderBy(clause)
+		if clauseOrderBy != "" {
+			if orderBy != "" {
+				return "", fmt.Errorf("only one JQL clause can have an ORDER BY, got %q and %q", orderBy, clauseOrderBy)
+			}
+			orderBy = clauseOrderBy
+		}
+		if filter != "" {
+			filters = append(filters, fmt.Sprintf("(%s)", filter))
+		}
+	}
+
+	jql := strings.Join(filters, " AND ")
+	if orderBy != "" {
+		jql = strings.TrimSpace(fmt.Sprintf("%s %s", jql, orderBy))
+	}
+
+	return jql, nil
+}
+
+var jqlOrderByRegex = regexp.MustCompile(`(?i)(^|[\s)])(order\s+by\s)`)
+
+// splitJQLOrderBy:: splits the JQL into the filter and the ORDER BY clause,
+// which JQL only allows at the end. Text in quotes, e.g. summary ~ "order by",
+// isn't taken as an ORDER BY.
+func splitJQLOrderBy(jql string) (string, string) {
+	jql = strings.TrimSpace(jql)
+
+	// Blank out the quoted text, keeping the positions of the rest
+	masked := []byte(jql)
+	var quote byte
+	for i := 0; i < len(masked); i++ {
+		c := masked[i]
+		switch {
+		case quote != 0 && c == '\\' && i+1 < len(masked):
+			masked[i], masked[i+1] = ' ', ' '
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			masked[i] = ' '
+		case c == '"' || c == '\'':
+			quote = c
+		}
+	}
+
+	match := jqlOrderByRegex.FindSubmatchIndex(masked)
+	if match == nil {
+		return jql, ""
+	}
+	return strings.TrimSpace(jql[:match[4]]), strings.TrimSpace(jql[match[4]:])
+}
+
 func getIssueJQLKey(columnName string) string {
+	if columnName == "status_category_key" {
+		return "statusCategory"
+	}
 	return strings.ToLower(strings.Split(columnName, "_")[0])
 }
+
+// statusCategoryIds maps the keys of the status categories to their IDs,
+// which are the same on all sites. JQL accepts the IDs but not the keys.
+var statusCategoryIds = map[string]string{
+	"undefined":     "1",
+	"new":           "2",
+	"done":          "3",
+	"indeterminate": "4",
+}
+
+// getIssueJQLValue:: converts a qual value to the value expected by JQL
+func getIssueJQLValue(columnName string, value string) string {
+	if columnName == "status_category_key" {
+		if id, ok := statusCategoryIds[value]; ok {
+			return id
+		}
+	}
+	return value
+}
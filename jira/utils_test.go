@@ -0,0 +1,415 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v3/connection"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/context_key"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestContext returns a context with the logger that plugin.Logger expects
+func newTestContext() context.Context {
+	return context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+}
+
+// newTestClient returns a client for a test server with the given handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *jira.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+	return client
+}
+
+func TestBuildJQLQueryFromQualsTimestamps(t *testing.T) {
+	columns := []*plugin.Column{
+		{Name: "created", Type: proto.ColumnType_TIMESTAMP},
+		{Name: "updated", Type: proto.ColumnType_TIMESTAMP},
+	}
+	timestampQuals := func(column, operator string, value time.Time) plugin.KeyColumnQualMap {
+		return plugin.KeyColumnQualMap{
+			column: &plugin.KeyColumnQuals{
+				Name: column,
+				Quals: quals.QualSlice{{
+					Column:   column,
+					Operator: operator,
+					Value:    &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(value)}},
+				}},
+			},
+		}
+	}
+
+	// 2024-01-01 23:30 UTC is 2024-01-02 01:30 in UTC+2
+	value := time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC)
+	withSeconds := time.Date(2024, 1, 1, 23, 30, 15, 0, time.UTC)
+	plusTwo := time.FixedZone("UTC+2", 2*60*60)
+
+	tests := []struct {
+		column   string
+		operator string
+		value    time.Time
+		location *time.Location
+		expected string
+	}{
+		{"updated", ">", value, time.UTC, `"updated" > "2024-01-01 23:30"`},
+		{"updated", ">=", value, time.UTC, `"updated" >= "2024-01-01 23:30"`},
+		{"updated", "<", value, time.UTC, `"updated" < "2024-01-01 23:30"`},
+		{"updated", "<=", value, time.UTC, `"updated" <= "2024-01-01 23:30"`},
+		{"created", "=", value, time.UTC, `"created" = "2024-01-01 23:30"`},
+		{"created", ">=", value, plusTwo, `"created" >= "2024-01-02 01:30"`},
+		{"created", ">=", value, nil, ""},
+
+		// Values with seconds are widened to the whole minute
+		{"updated", ">", withSeconds, time.UTC, `"updated" >= "2024-01-01 23:30"`},
+		{"updated", ">=", withSeconds, time.UTC, `"updated" >= "2024-01-01 23:30"`},
+		{"updated", "<", withSeconds, time.UTC, `"updated" < "2024-01-01 23:31"`},
+		{"updated", "<=", withSeconds, time.UTC, `"updated" < "2024-01-01 23:31"`},
+		{"created", "=", withSeconds, time.UTC, `"created" >= "2024-01-01 23:30" AND "created" < "2024-01-01 23:31"`},
+		{"created", "<>", withSeconds, time.UTC, ""},
+		{"created", "<", withSeconds, plusTwo, `"created" < "2024-01-02 01:31"`},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s %s %v", test.column, test.operator, test.value.Format("15:04:05"), test.location), func(t *testing.T) {
+			actual := buildJQLQueryFromQuals(timestampQuals(test.column, test.operator, test.value), columns, test.location)
+			if actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestHasTimestampQual(t *testing.T) {
+	columns := []*plugin.Column{
+		{Name: "key", Type: proto.ColumnType_STRING},
+		{Name: "updated", Type: proto.ColumnType_TIMESTAMP},
+	}
+	qual := func(column string) plugin.KeyColumnQualMap {
+		return plugin.KeyColumnQualMap{column: &plugin.KeyColumnQuals{Name: column, Quals: quals.QualSlice{{Column: column, Operator: "="}}}}
+	}
+
+	if hasTimestampQual(qual("key"), columns) {
+		t.Error("expected no timestamp qual for key")
+	}
+	if !hasTimestampQual(qual("updated"), columns) {
+		t.Error("expected a timestamp qual for updated")
+	}
+}
+
+// newTestQueryData returns query data for the given connection config, with
+// the client cached so that connect returns it
+func newTestQueryData(client *jira.Client, config jiraConfig) *plugin.QueryData {
+	d := &plugin.QueryData{
+		Connection:        &plugin.Connection{Config: config},
+		ConnectionManager: connection.NewManager(),
+		QueryContext:      &plugin.QueryContext{},
+	}
+	d.ConnectionManager.Cache.Set("atlassian-jira", client)
+	return d
+}
+
+func TestCombineJQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		clauses  []string
+		expected string
+	}{
+		{"no clauses", []string{"", ""}, ""},
+		{"single clause", []string{"", "project = ENG"}, "(project = ENG)"},
+		{"default and user JQL", []string{"project in (ENG, OPS)", "status = Done OR priority = High"}, "(project in (ENG, OPS)) AND (status = Done OR priority = High)"},
+		{"trailing order by", []string{"project = ENG", "status = Done order by created desc"}, "(project = ENG) AND (status = Done) order by created desc"},
+		{"order by only", []string{"project = ENG", "ORDER BY rank"}, "(project = ENG) ORDER BY rank"},
+		{"order by after parentheses", []string{"(status = Done)ORDER BY rank"}, "((status = Done)) ORDER BY rank"},
+		{"order by in double quotes", []string{`summary ~ "order by date"`}, `(summary ~ "order by date")`},
+		{"order by in single quotes", []string{`summary ~ 'sort order by date' order by key`}, `(summary ~ 'sort order by date') order by key`},
+		{"escaped quote", []string{`summary ~ "say \"order by \" now"`}, `(summary ~ "say \"order by \" now")`},
+		{"order by in a word", []string{"reorder by = x"}, "(reorder by = x)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := combineJQL(test.clauses...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCombineJQLSeveralOrderBys(t *testing.T) {
+	_, err := combineJQL("project = ENG order by rank", "status = Done order by created")
+	if err == nil {
+		t.Error("expected an error for several ORDER BY clauses")
+	}
+}
+
+func TestGetJiraErrorMessage(t *testing.T) {
+	requestErr := errors.New("request failed")
+	newResponse := func(contentType string, body string) *jira.Response {
+		return &jira.Response{Response: &http.Response{
+			Status:     "400 Bad Request",
+			StatusCode: http.StatusBadRequest,
+			Header:     http.Header{"Content-Type": []string{contentType}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		resp     *jira.Response
+		err      error
+		expected string
+	}{
+		{
+			"error messages",
+			newResponse("application/json", `{"errorMessages": ["Field 'foo' does not exist.", "Another problem."]}`),
+			requestErr,
+			"Field 'foo' does not exist.; Another problem.",
+		},
+		{
+			"field errors",
+			newResponse("application/json;charset=UTF-8", `{"errorMessages": [], "errors": {"summary": "Summary is required.", "project": "Project is required."}}`),
+			requestErr,
+			"project: Project is required.; summary: Summary is required.",
+		},
+		{
+			"error messages and field errors",
+			newResponse("application/json", `{"errorMessages": ["Invalid request."], "errors": {"summary": "Summary is required."}}`),
+			requestErr,
+			"Invalid request.; summary: Summary is required.",
+		},
+		{
+			"no messages",
+			newResponse("application/json", `{"errorMessages": [], "errors": {}}`),
+			requestErr,
+			"request failed",
+		},
+		{
+			"non-JSON body",
+			newResponse("text/html", "<html>Bad gateway</html>"),
+			requestErr,
+			"400 Bad Request: <html>Bad gateway</html>: request failed",
+		},
+		{
+			"nil response",
+			nil,
+			requestErr,
+			"request failed",
+		},
+		{
+			"API error",
+			nil,
+			&jiraAPIError{Method: "GET", Path: "/rest/api/2/myself", StatusCode: 401, Message: "Unauthorized"},
+			"Unauthorized",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := getJiraErrorMessage(test.resp, test.err)
+			if actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDoRequestError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages": ["You do not have permission to view this issue."]}`)
+	})
+
+	req, err := client.NewRequest("GET", "rest/api/2/issue/TEST-1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = doRequest(newTestContext(), client, req, nil)
+
+	expected := "jira api error: GET /rest/api/2/issue/TEST-1 -> 403: You do not have permission to view this issue."
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+	if !isForbiddenError(err) {
+		t.Errorf("expected a forbidden error, got %v", err)
+	}
+}
+
+func TestConnectValidatesConfig(t *testing.T) {
+	stringPtr := func(s string) *string { return &s }
+	newConfig := func(baseUrl string) jiraConfig {
+		return jiraConfig{BaseUrl: stringPtr(baseUrl), Username: stringPtr("abcd@xyz.com"), Token: stringPtr("token")}
+	}
+
+	tests := []struct {
+		name          string
+		config        jiraConfig
+		expectedError string
+	}{
+		{"valid", newConfig("https://your-domain.atlassian.net"), ""},
+		{"trailing slash", newConfig("https://your-domain.atlassian.net/"), ""},
+		{"http", newConfig("http://jira.internal:8080/jira/"), ""},
+		{"missing", jiraConfig{Username: stringPtr("abcd@xyz.com"), Token: stringPtr("token")}, "'base_url' must be set"},
+		{"empty", newConfig(""), "'base_url' must be set"},
+		{"relative", newConfig("your-domain.atlassian.net"), "'base_url' must be an absolute http or https URL"},
+		{"path only", newConfig("/jira"), "'base_url' must be an absolute http or https URL"},
+		{"non-http scheme", newConfig("ftp://your-domain.atlassian.net"), "'base_url' must be an absolute http or https URL"},
+		{"missing username", jiraConfig{BaseUrl: stringPtr("https://your-domain.atlassian.net"), Token: stringPtr("token")}, "'username' must be set"},
+		{"missing token", jiraConfig{BaseUrl: stringPtr("https://your-domain.atlassian.net"), Username: stringPtr("abcd@xyz.com")}, "'token' must be set"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := &plugin.QueryData{
+				Connection:        &plugin.Connection{Config: test.config},
+				ConnectionManager: connection.NewManager(),
+			}
+
+			client, err := connect(newTestContext(), d)
+			if test.expectedError == "" {
+				if err != nil || client == nil {
+					t.Errorf("expected a client, got error %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedError) {
+				t.Errorf("expected error starting with %q, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestIsCloudDeployment(t *testing.T) {
+	tests := []struct {
+		deploymentType string
+		expected       bool
+	}{
+		{"Cloud", true},
+		{"Server", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.deploymentType, func(t *testing.T) {
+			calls := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Path != "/rest/api/2/serverInfo" {
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+				fmt.Fprintf(w, `{"baseUrl": "https://jira.example.com", "deploymentType": %q}`, test.deploymentType)
+			})
+			d := newTestQueryData(client, jiraConfig{})
+
+			// The server info is read once and cached for the connection
+			for i := 0; i < 2; i++ {
+				isCloud, err := isCloudDeployment(newTestContext(), d)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if isCloud != test.expected {
+					t.Errorf("expected %v, got %v", test.expected, isCloud)
+				}
+			}
+			if calls != 1 {
+				t.Errorf("expected 1 server info call, got %d", calls)
+			}
+
+			cachedData, ok := d.ConnectionManager.Cache.Get("jira-server-info")
+			if !ok || cachedData.(*ServerInfo).DeploymentType != test.deploymentType {
+				t.Errorf("expected the server info to be cached, got %v", cachedData)
+			}
+		})
+	}
+}
+
+func TestGetStoryPointField(t *testing.T) {
+	configured := "customfield_10099"
+
+	tests := []struct {
+		name          string
+		fields        string
+		config        jiraConfig
+		expected      string
+		expectedCalls int
+	}{
+		{
+			"story points",
+			`[{"id": "summary", "name": "Summary", "custom": false}, {"id": "customfield_10016", "name": "Story point estimate", "custom": true}, {"id": "customfield_10028", "name": "Story Points", "custom": true}]`,
+			jiraConfig{},
+			"customfield_10028",
+			1,
+		},
+		{
+			"story point estimate",
+			`[{"id": "summary", "name": "Summary", "custom": false}, {"id": "customfield_10016", "name": "Story point estimate", "custom": true}]`,
+			jiraConfig{},
+			"customfield_10016",
+			1,
+		},
+		{
+			"no estimate field",
+			`[{"id": "summary", "name": "Summary", "custom": false}]`,
+			jiraConfig{},
+			"",
+			1,
+		},
+		{
+			"configured field",
+			`[{"id": "customfield_10028", "name": "Story Points", "custom": true}]`,
+			jiraConfig{StoryPointField: &configured},
+			"customfield_10099",
+			0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Path != "/rest/api/2/field" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				fmt.Fprint(w, test.fields)
+			})
+			d := newTestQueryData(client, test.config)
+
+			// The discovered field is cached for the connection
+			for i := 0; i < 2; i++ {
+				fieldId, err := getStoryPointField(newTestContext(), d)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if fieldId != test.expected {
+					t.Errorf("expected %q, got %q", test.expected, fieldId)
+				}
+			}
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d field list calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}